@@ -5,6 +5,7 @@ import (
 
 	"github.com/genvmoroz/custom-collector/internal/core/autocleanup"
 	"github.com/genvmoroz/custom-collector/internal/http"
+	"github.com/genvmoroz/custom-collector/internal/repository/stats"
 	"github.com/go-playground/validator/v10"
 	"github.com/kelseyhightower/envconfig"
 )
@@ -14,6 +15,7 @@ type Config struct {
 
 	AutoCleanupTask autocleanup.Config
 	HTTPServer      http.Config
+	Picker          stats.Config
 }
 
 func FromEnv() (Config, error) {
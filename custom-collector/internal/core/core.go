@@ -6,7 +6,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math"
 	"time"
 
 	"github.com/samber/lo"
@@ -17,9 +16,10 @@ type (
 		Now() time.Time
 	}
 
+	// StatsRepo returns the stats of the hardware with the given IDs, of all the hardware if no ID is given.
+	// The sensors and their values are read at once, so they belong to the same snapshot.
 	StatsRepo interface {
-		GetSensorsByHardware(ctx context.Context) (map[Hardware][]Sensor, error)
-		GetCurrentSensorValues(ctx context.Context) (map[Sensor]float32, error)
+		GetSensorValues(ctx context.Context, hardwareIDs []HardwareID) (Snapshot, error)
 	}
 
 	Store interface {
@@ -55,49 +55,37 @@ func (s *Service) GetStats(ctx context.Context, req GetStatsRequest) (GetStatsRe
 	zero := GetStatsResponse{}
 
 	if err := req.Validate(); err != nil {
-		return zero, fmt.Errorf("validate request: %w", err)
+		return zero, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
 	}
 
 	now := s.timeGenerator.Now()
 
-	sensorsByHardware, err := s.statsRepo.GetSensorsByHardware(ctx)
+	snapshot, err := s.statsRepo.GetSensorValues(ctx, req.HardwareIDs)
 	if err != nil {
-		return zero, fmt.Errorf("get sensors: %w", err)
+		return zero, fmt.Errorf("get sensor values: %w", err)
 	}
 
-	currentValues, err := s.statsRepo.GetCurrentSensorValues(ctx)
-	if err != nil {
-		return zero, fmt.Errorf("get current values: %w", err)
+	if err = s.storeValues(now, snapshot.Values); err != nil {
+		return zero, fmt.Errorf("store values: %w", err)
 	}
 
-	if err = s.storeValues(now, sensorsByHardware, currentValues); err != nil {
-		return zero, fmt.Errorf("store values: %w", err)
+	resp, err := s.getValuesForRange(now, req, snapshot.Values)
+	if err != nil {
+		return zero, err
 	}
+	resp.Warnings = snapshot.Warnings
 
-	return s.getValuesForRange(now, req, sensorsByHardware)
+	return resp, nil
 }
 
-func (s *Service) storeValues(
-	now time.Time,
-	sensorsByHardware map[Hardware][]Sensor,
-	currentValues map[Sensor]float32,
-) error {
-	for _, sensors := range sensorsByHardware {
-		if len(sensors) == 0 {
-			continue
-		}
-
+func (s *Service) storeValues(now time.Time, sensorValues map[Hardware][]SensorValue) error {
+	for _, sensors := range sensorValues {
 		for _, sensor := range sensors {
-			currentSensorValue, ok := currentValues[sensor]
-			if !ok {
-				continue
-			}
-
 			value := Value{
-				Value:     int64(math.Round(float64(currentSensorValue))),
+				Value:     sensor.Value,
 				Timestamp: now,
 			}
-			if err := s.store.StoreValue(sensor.ID, value); err != nil {
+			if err := s.store.StoreValue(sensor.Sensor.ID, value); err != nil {
 				return fmt.Errorf("store value: %w", err)
 			}
 		}
@@ -106,15 +94,16 @@ func (s *Service) storeValues(
 	return nil
 }
 
-func (s *Service) getValuesForRange(now time.Time, req GetStatsRequest, sensorsByHardware map[Hardware][]Sensor) (GetStatsResponse, error) {
+func (s *Service) getValuesForRange(now time.Time, req GetStatsRequest, sensorValues map[Hardware][]SensorValue) (GetStatsResponse, error) {
 	resp := GetStatsResponse{
 		Stats: map[Hardware]map[SensorType]map[Sensor][]Value{},
 	}
 
-	for hardware, sensors := range sensorsByHardware {
+	for hardware, sensors := range sensorValues {
 		resp.Stats[hardware] = make(map[SensorType]map[Sensor][]Value, len(sensors))
 
-		for _, sensor := range sensors {
+		for _, sv := range sensors {
+			sensor := sv.Sensor
 			if _, ok := resp.Stats[hardware][sensor.Type]; !ok {
 				resp.Stats[hardware][sensor.Type] = make(map[Sensor][]Value, len(sensors))
 			}
@@ -2,7 +2,6 @@ package core_test
 
 import (
 	"context"
-	"math"
 	"testing"
 	"time"
 
@@ -29,6 +28,32 @@ func TestServiceGetStats(t *testing.T) {
 				require.Equal(t, data.resp, got)
 			},
 		},
+		{
+			Desc: "partial stats",
+			EditData: func(data *testData) {
+				data.warnings = []string{"get stats of laptop:8080: connection refused"}
+				data.resp.Warnings = data.warnings
+			},
+			EditFlow: nil,
+			TestFunc: func(service *core.Service, data testData) {
+				got, err := service.GetStats(data.ctx, data.req)
+				require.NoError(t, err)
+				require.Equal(t, data.resp, got)
+			},
+		},
+		{
+			Desc: "invalid range",
+			EditData: func(data *testData) {
+				data.req.ForRange = 0
+			},
+			EditFlow: func(_ testData, _ testDeps, hooks *testutils.HookSet) {
+				hooks.DisableAll()
+			},
+			TestFunc: func(service *core.Service, data testData) {
+				_, err := service.GetStats(data.ctx, data.req)
+				require.ErrorIs(t, err, core.ErrInvalidRequest)
+			},
+		},
 		// todo: add more test cases
 	}
 	for _, test := range tests {
@@ -43,8 +68,8 @@ type testData struct {
 
 	now time.Time
 
-	sensorsByHardware           map[core.Hardware][]core.Sensor
-	currentValuesBySensor       map[core.Sensor]float32
+	sensorValues                map[core.Hardware][]core.SensorValue
+	warnings                    []string
 	valuesPerSensorsForHardware map[core.Hardware]map[core.SensorType]map[core.Sensor][]core.Value
 }
 
@@ -78,16 +103,16 @@ func initTestData(t *testing.T) testData {
 	}
 
 	var (
-		currentCPU0ClockValue = core.Value{Value: int64(850), Timestamp: now}
-		currentCPU1ClockValue = core.Value{Value: int64(860), Timestamp: now}
-		currentCPU0TempValue  = core.Value{Value: int64(42), Timestamp: now}
-		currentCPU1TempValue  = core.Value{Value: int64(43), Timestamp: now}
-		currentGPU0ClockValue = core.Value{Value: int64(1550), Timestamp: now}
-		currentGPU1ClockValue = core.Value{Value: int64(1560), Timestamp: now}
-		currentGPU0TempValue  = core.Value{Value: int64(52), Timestamp: now}
-		currentGPU1TempValue  = core.Value{Value: int64(53), Timestamp: now}
-		currentRAM0UsageValue = core.Value{Value: int64(40), Timestamp: now}
-		currentRAM1UsageValue = core.Value{Value: int64(45), Timestamp: now}
+		currentCPU0ClockValue = core.Value{Value: 850, Timestamp: now}
+		currentCPU1ClockValue = core.Value{Value: 860, Timestamp: now}
+		currentCPU0TempValue  = core.Value{Value: 42.5, Timestamp: now}
+		currentCPU1TempValue  = core.Value{Value: 43.25, Timestamp: now}
+		currentGPU0ClockValue = core.Value{Value: 1550, Timestamp: now}
+		currentGPU1ClockValue = core.Value{Value: 1560, Timestamp: now}
+		currentGPU0TempValue  = core.Value{Value: 52, Timestamp: now}
+		currentGPU1TempValue  = core.Value{Value: 53, Timestamp: now}
+		currentRAM0UsageValue = core.Value{Value: 40, Timestamp: now}
+		currentRAM1UsageValue = core.Value{Value: 45, Timestamp: now}
 	)
 
 	var (
@@ -106,7 +131,8 @@ func initTestData(t *testing.T) testData {
 	return testData{
 		ctx: context.Background(),
 		req: core.GetStatsRequest{
-			ForRange: time.Hour,
+			ForRange:    time.Hour,
+			HardwareIDs: []core.HardwareID{cpu.ID, gpu.ID},
 		},
 		resp: core.GetStatsResponse{
 			Stats: map[core.Hardware]map[core.SensorType]map[core.Sensor][]core.Value{
@@ -139,23 +165,21 @@ func initTestData(t *testing.T) testData {
 			},
 		},
 		now: now,
-		sensorsByHardware: map[core.Hardware][]core.Sensor{
-			cpu:  {cpu0Clock, cpu1Clock, cpu0Temp, cpu1Temp},
-			gpu:  {gpu0Clock, gpu1Clock, gpu0Temp, gpu1Temp},
-			ram0: {ram0Usage},
-			ram1: {ram1Usage},
-		},
-		currentValuesBySensor: map[core.Sensor]float32{
-			cpu0Clock: float32(currentCPU0ClockValue.Value),
-			cpu1Clock: float32(currentCPU1ClockValue.Value),
-			cpu0Temp:  float32(currentCPU0TempValue.Value),
-			cpu1Temp:  float32(currentCPU1TempValue.Value),
-			gpu0Clock: float32(currentGPU0ClockValue.Value),
-			gpu1Clock: float32(currentGPU1ClockValue.Value),
-			gpu0Temp:  float32(currentGPU0TempValue.Value),
-			gpu1Temp:  float32(currentGPU1TempValue.Value),
-			ram0Usage: float32(currentRAM0UsageValue.Value),
-			ram1Usage: float32(currentRAM1UsageValue.Value),
+		sensorValues: map[core.Hardware][]core.SensorValue{
+			cpu: {
+				{Sensor: cpu0Clock, Value: currentCPU0ClockValue.Value},
+				{Sensor: cpu1Clock, Value: currentCPU1ClockValue.Value},
+				{Sensor: cpu0Temp, Value: currentCPU0TempValue.Value},
+				{Sensor: cpu1Temp, Value: currentCPU1TempValue.Value},
+			},
+			gpu: {
+				{Sensor: gpu0Clock, Value: currentGPU0ClockValue.Value},
+				{Sensor: gpu1Clock, Value: currentGPU1ClockValue.Value},
+				{Sensor: gpu0Temp, Value: currentGPU0TempValue.Value},
+				{Sensor: gpu1Temp, Value: currentGPU1TempValue.Value},
+			},
+			ram0: {{Sensor: ram0Usage, Value: currentRAM0UsageValue.Value}},
+			ram1: {{Sensor: ram1Usage, Value: currentRAM1UsageValue.Value}},
 		},
 		valuesPerSensorsForHardware: map[core.Hardware]map[core.SensorType]map[core.Sensor][]core.Value{
 			cpu: {
@@ -217,11 +241,10 @@ func initTestDeps(t *testing.T) testDeps {
 }
 
 const (
-	testHookNow                   = "Now"
-	testHookGetSensorsByHardware  = "GetSensorsByHardware"
-	testHookStoreValue            = "StoreValue"
-	testHookGetStatsForRange      = "GetStatsForRange"
-	testHookGetCurrentSensorValue = "GetCurrentSensorValue"
+	testHookNow              = "Now"
+	testHookGetSensorValues  = "GetSensorValues"
+	testHookStoreValue       = "StoreValue"
+	testHookGetStatsForRange = "GetStatsForRange"
 )
 
 func initTestHookSet(deps testDeps, data testData) testutils.HookSet {
@@ -232,28 +255,24 @@ func initTestHookSet(deps testDeps, data testData) testutils.HookSet {
 		data.now,
 	)
 	hooks.Add(
-		testHookGetSensorsByHardware,
-		deps.statsRepo.EXPECT().GetSensorsByHardware(data.ctx),
-		data.sensorsByHardware,
+		testHookGetSensorValues,
+		deps.statsRepo.EXPECT().GetSensorValues(data.ctx, data.req.HardwareIDs),
+		core.Snapshot{Values: data.sensorValues, Warnings: data.warnings},
 		nil,
 	)
-	hooks.Add(
-		testHookGetCurrentSensorValue,
-		deps.statsRepo.EXPECT().GetCurrentSensorValues(data.ctx),
-		data.currentValuesBySensor,
-		nil,
-	)
-	for sensor, currentSensorValue := range data.currentValuesBySensor {
-		value := core.Value{
-			Value:     int64(math.Round(float64(currentSensorValue))),
-			Timestamp: data.now,
-		}
+	for _, sensors := range data.sensorValues {
+		for _, sensor := range sensors {
+			value := core.Value{
+				Value:     sensor.Value,
+				Timestamp: data.now,
+			}
 
-		hooks.Add(
-			testHookStoreValue,
-			deps.store.EXPECT().StoreValue(sensor.ID, value),
-			nil,
-		)
+			hooks.Add(
+				testHookStoreValue,
+				deps.store.EXPECT().StoreValue(sensor.Sensor.ID, value),
+				nil,
+			)
+		}
 	}
 
 	for _, sensorType := range data.valuesPerSensorsForHardware {
@@ -278,7 +297,7 @@ func generateValuesForRange(from, to time.Time, step time.Duration) []core.Value
 	for t := from; t.Before(to); t = t.Add(step) {
 		values = append(values,
 			core.Value{
-				Value:     rand.N[int64](10) + 40,
+				Value:     float64(rand.N[int64](10) + 40),
 				Timestamp: time.UnixMilli(t.UnixMilli()), // to round to milliseconds
 			},
 		)
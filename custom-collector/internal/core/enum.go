@@ -1,82 +1,92 @@
 package core
 
-//go:generate stringer -output=enum_strings.go -type=HardwareType,SensorType,Unit
+import (
+	"github.com/genvmoroz/win-stats/domain"
+)
 
-type HardwareType int
+// The types are the ones picker reports, they are shared by the domain module.
 
-const (
-	UnknownHardwareType HardwareType = iota
-	Motherboard
-	SuperIO
-	CPU
-	GPU
-	TBalancer
-	HeatMaster
-	HDD
-	RAM
+type (
+	HardwareType = domain.HardwareType
+	SensorType   = domain.SensorType
+	Unit         = domain.Unit
 )
 
-type SensorType int
-
 const (
-	UnknownSensorType SensorType = iota
-	Voltage
-	Clock
-	Temperature
-	Load
-	Fan
-	Flow
-	Control
-	Level
-	Power
-	SmallData
-	Throughput
-	Data
+	UnknownHardwareType = domain.UnknownHardwareType
+	SuperIO             = domain.SuperIO
+	CPU                 = domain.CPU
+	GPU                 = domain.GPU
+	TBalancer           = domain.TBalancer
+	HeatMaster          = domain.HeatMaster
+	HDD                 = domain.HDD
+	RAM                 = domain.RAM
+	Network             = domain.Network
+	Memory              = domain.Memory
+	Storage             = domain.Storage
+	Motherboard         = domain.Motherboard
+	Battery             = domain.Battery
+	Cooler              = domain.Cooler
+	EmbeddedController  = domain.EmbeddedController
+	PSU                 = domain.PSU
 )
 
-type Unit int
+const (
+	UnknownSensorType = domain.UnknownSensorType
+	Voltage           = domain.Voltage
+	Clock             = domain.Clock
+	Temperature       = domain.Temperature
+	Load              = domain.Load
+	Fan               = domain.Fan
+	Flow              = domain.Flow
+	Control           = domain.Control
+	Level             = domain.Level
+	Power             = domain.Power
+	SmallData         = domain.SmallData
+	Throughput        = domain.Throughput
+	Data              = domain.Data
+	Factor            = domain.Factor
+	Energy            = domain.Energy
+	Current           = domain.Current
+	Frequency         = domain.Frequency
+	TimeSpan          = domain.TimeSpan
+	Noise             = domain.Noise
+	Conductivity      = domain.Conductivity
+	Humidity          = domain.Humidity
+)
 
 const (
-	UnknownUnit Unit = iota
-	Volt
-	Megahertz
-	Celsius
-	Percentage
-	RevolutionsPerMinute
-	LitersPerHour
-	Watts
-	Gigabytes
-	Megabytes
-	KilobytesPerSecond
+	UnknownUnit               = domain.UnknownUnit
+	Volt                      = domain.Volt
+	Megahertz                 = domain.Megahertz
+	Celsius                   = domain.Celsius
+	Percentage                = domain.Percentage
+	RevolutionsPerMinute      = domain.RevolutionsPerMinute
+	LitersPerHour             = domain.LitersPerHour
+	Watts                     = domain.Watts
+	Gigabytes                 = domain.Gigabytes
+	Megabytes                 = domain.Megabytes
+	KilobytesPerSecond        = domain.KilobytesPerSecond
+	Amperes                   = domain.Amperes
+	MilliwattHours            = domain.MilliwattHours
+	BytesPerSecond            = domain.BytesPerSecond
+	MegabytesPerSecond        = domain.MegabytesPerSecond
+	Terabytes                 = domain.Terabytes
+	Fahrenheit                = domain.Fahrenheit
+	Gigahertz                 = domain.Gigahertz
+	Ratio                     = domain.Ratio
+	Hertz                     = domain.Hertz
+	Seconds                   = domain.Seconds
+	Decibels                  = domain.Decibels
+	MicrosiemensPerCentimeter = domain.MicrosiemensPerCentimeter
 )
 
-func (st SensorType) Unit() Unit {
-	switch st {
-	case Voltage:
-		return Volt
-	case Clock:
-		return Megahertz
-	case Temperature:
-		return Celsius
-	case Load:
-		return Percentage
-	case Fan:
-		return RevolutionsPerMinute
-	case Flow:
-		return LitersPerHour
-	case Control:
-		return Percentage
-	case Level:
-		return Percentage
-	case Power:
-		return Watts
-	case SmallData:
-		return Megabytes
-	case Throughput:
-		return KilobytesPerSecond
-	case Data:
-		return Gigabytes
-	default:
-		return UnknownUnit
-	}
+// ParseHardwareType returns the hardware type by its name as reported by picker, the name is case-insensitive.
+func ParseHardwareType(name string) (HardwareType, error) {
+	return domain.ParseHardwareType(name)
+}
+
+// ParseSensorType returns the sensor type by its name as reported by picker, the name is case-insensitive.
+func ParseSensorType(name string) (SensorType, error) {
+	return domain.ParseSensorType(name)
 }
@@ -78,34 +78,19 @@ func (m *MockStatsRepo) EXPECT() *MockStatsRepoMockRecorder {
 	return m.recorder
 }
 
-// GetCurrentSensorValues mocks base method.
-func (m *MockStatsRepo) GetCurrentSensorValues(ctx context.Context) (map[core.Sensor]float32, error) {
+// GetSensorValues mocks base method.
+func (m *MockStatsRepo) GetSensorValues(ctx context.Context, hardwareIDs []core.HardwareID) (core.Snapshot, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCurrentSensorValues", ctx)
-	ret0, _ := ret[0].(map[core.Sensor]float32)
+	ret := m.ctrl.Call(m, "GetSensorValues", ctx, hardwareIDs)
+	ret0, _ := ret[0].(core.Snapshot)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCurrentSensorValues indicates an expected call of GetCurrentSensorValues.
-func (mr *MockStatsRepoMockRecorder) GetCurrentSensorValues(ctx any) *gomock.Call {
+// GetSensorValues indicates an expected call of GetSensorValues.
+func (mr *MockStatsRepoMockRecorder) GetSensorValues(ctx, hardwareIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentSensorValues", reflect.TypeOf((*MockStatsRepo)(nil).GetCurrentSensorValues), ctx)
-}
-
-// GetSensorsByHardware mocks base method.
-func (m *MockStatsRepo) GetSensorsByHardware(ctx context.Context) (map[core.Hardware][]core.Sensor, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSensorsByHardware", ctx)
-	ret0, _ := ret[0].(map[core.Hardware][]core.Sensor)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetSensorsByHardware indicates an expected call of GetSensorsByHardware.
-func (mr *MockStatsRepoMockRecorder) GetSensorsByHardware(ctx any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSensorsByHardware", reflect.TypeOf((*MockStatsRepo)(nil).GetSensorsByHardware), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSensorValues", reflect.TypeOf((*MockStatsRepo)(nil).GetSensorValues), ctx, hardwareIDs)
 }
 
 // MockStore is a mock of Store interface.
@@ -1,42 +1,62 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 	"time"
+
+	"github.com/genvmoroz/win-stats/domain"
 )
 
 type (
 	GetStatsRequest struct {
 		ForRange time.Duration
+		// HardwareIDs limit the stats to the hardware, all the hardware is returned if it is empty.
+		HardwareIDs []HardwareID
 	}
 
 	GetStatsResponse struct {
 		Stats map[Hardware]map[SensorType]map[Sensor][]Value
+		// Warnings tell the stats are partial, e.g. a picker host is down.
+		Warnings []string
 	}
 )
 
+// ErrInvalidRequest is wrapped by the errors of the requests that can't be served, e.g. of unknown hardware.
+var ErrInvalidRequest = errors.New("invalid request")
+
 type (
-	HardwareID string
-	SensorID   string
+	HardwareID = domain.HardwareID
+	SensorID   = domain.SensorID
 )
 
 type Value struct {
-	Value     int64
+	Value     float64
 	Timestamp time.Time
 }
 
-type Hardware struct {
-	ID   HardwareID
-	Name string
-	Type HardwareType
-}
+// Hardware is the one picker reports, only ID, Name, Type and Subtype are kept.
+type Hardware = domain.Hardware
 
+// Sensor is keyed by its identity, so unlike the domain one it has no current value.
 type Sensor struct {
 	ID         SensorID
 	HardwareID HardwareID
 	Name       string
 	Type       SensorType
-	MaxValue   int64
+	MaxValue   float64
+}
+
+// SensorValue is the sensor with the value it had when the stats were read.
+type SensorValue struct {
+	Sensor Sensor
+	Value  float64
+}
+
+// Snapshot is the sensor values read at once, Warnings tell the hardware that couldn't be read.
+type Snapshot struct {
+	Values   map[Hardware][]SensorValue
+	Warnings []string
 }
 
 func (r GetStatsRequest) Validate() error {
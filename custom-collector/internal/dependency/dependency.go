@@ -3,7 +3,6 @@ package dependency
 import (
 	"github.com/genvmoroz/custom-collector/internal/core/autocleanup"
 	"github.com/genvmoroz/custom-collector/internal/http"
-	"github.com/genvmoroz/custom-collector/internal/repository/stats"
 	"github.com/genvmoroz/custom-collector/internal/repository/timegen"
 	"github.com/samber/do"
 )
@@ -17,11 +16,11 @@ func Build() Dependency {
 	injector := do.DefaultInjector
 
 	do.ProvideValue(injector, timegen.NewTimeGenerator())
-	do.ProvideValue(injector, stats.NewRepo())
 
 	do.Provide(injector, NewConfig)
 	do.Provide(injector, NewLogger)
 	do.Provide(injector, NewMemStore)
+	do.Provide(injector, NewStatsRepo)
 	do.Provide(injector, NewAutoCleanup)
 	do.Provide(injector, NewService)
 	do.Provide(injector, NewHTTPServer)
@@ -1,7 +1,9 @@
 package dependency
 
 import (
+	"github.com/genvmoroz/custom-collector/internal/config"
 	"github.com/genvmoroz/custom-collector/internal/repository/mem"
+	"github.com/genvmoroz/custom-collector/internal/repository/stats"
 	"github.com/samber/do"
 	"github.com/sirupsen/logrus"
 )
@@ -11,3 +13,12 @@ func NewMemStore(injector *do.Injector) (*mem.Store, error) {
 
 	return mem.NewStore(logger)
 }
+
+func NewStatsRepo(injector *do.Injector) (*stats.Repo, error) {
+	var (
+		cfg    = do.MustInvoke[config.Config](injector)
+		logger = do.MustInvoke[logrus.FieldLogger](injector)
+	)
+
+	return stats.NewRepo(cfg.Picker, logger)
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/genvmoroz/custom-collector/internal/core"
@@ -13,10 +14,14 @@ import (
 type (
 	GetStatsRequest struct {
 		Range string `query:"range"`
+		// HardwareIDs are the repeated hardwareId parameters, the stats of all the hardware are returned without them.
+		HardwareIDs []string `query:"hardwareId"`
 	}
 
 	GetStatsResponse struct {
 		Stats Stats `json:"stats"`
+		// Warnings tell the stats are partial, e.g. a picker host is down.
+		Warnings []string `json:"warnings,omitempty"`
 	}
 
 	Stats struct {
@@ -36,18 +41,18 @@ type (
 
 	Sensor struct {
 		Name     string
-		MaxValue int64
+		MaxValue float64
 		Values   []Value
 	}
 
 	Value struct {
-		Value     int64 `json:"value"`
-		Timestamp int64 `json:"timestamp"`
+		Value     float64 `json:"value"`
+		Timestamp int64   `json:"timestamp"`
 	}
 )
 
 func fromCoreResp(in core.GetStatsResponse) GetStatsResponse {
-	out := GetStatsResponse{}
+	out := GetStatsResponse{Warnings: in.Warnings}
 
 	var (
 		hwLen   = len(in.Stats)
@@ -121,13 +126,23 @@ func toCoreGetStatsRequest(in GetStatsRequest) (core.GetStatsRequest, error) {
 		return zero, fmt.Errorf("parse duration: %w", err)
 	}
 
+	var hardwareIDs []core.HardwareID
+	for _, id := range in.HardwareIDs {
+		hardwareIDs = append(hardwareIDs, core.HardwareID(id))
+	}
+
 	return core.GetStatsRequest{
-		ForRange: duration,
+		ForRange:    duration,
+		HardwareIDs: hardwareIDs,
 	}, nil
 }
 
+// hardwareName shows the subtype unless it just repeats the type, e.g. "GPU (GpuIntel): ..." but "CPU: ...".
 func hardwareName(hw core.Hardware) string {
-	return fmt.Sprintf("%s: %s [%s]", hw.Type, hw.Name, hw.ID)
+	if hw.Subtype == "" || strings.EqualFold(hw.Subtype, hw.Type.String()) {
+		return fmt.Sprintf("%s: %s [%s]", hw.Type, hw.Name, hw.ID)
+	}
+	return fmt.Sprintf("%s (%s): %s [%s]", hw.Type, hw.Subtype, hw.Name, hw.ID)
 }
 
 func sensorName(s core.Sensor) string {
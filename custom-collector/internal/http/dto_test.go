@@ -13,7 +13,7 @@ func TestRespFromCore(t *testing.T) {
 
 	var (
 		cpu  = core.Hardware{ID: "/cpu0", Name: "INTEL CORE I7-7700K", Type: core.CPU}
-		gpu  = core.Hardware{ID: "/gpu0", Name: "NVIDIA GEFORCE GTX 1080", Type: core.GPU}
+		gpu  = core.Hardware{ID: "/gpu0", Name: "NVIDIA GEFORCE GTX 1080", Type: core.GPU, Subtype: "GpuNvidia"}
 		ram0 = core.Hardware{ID: "/ram0", Name: "KINGSTON DDR4 16GB", Type: core.RAM}
 		ram1 = core.Hardware{ID: "/ram1", Name: "KINGSTON DDR4 16GB", Type: core.RAM}
 	)
@@ -95,6 +95,7 @@ func TestRespFromCore(t *testing.T) {
 				},
 			},
 		},
+		Warnings: []string{"get stats of laptop:8080: connection refused"},
 	}
 
 	want := GetStatsResponse{
@@ -154,7 +155,7 @@ func TestRespFromCore(t *testing.T) {
 					},
 				},
 				{
-					Name: "GPU: NVIDIA GEFORCE GTX 1080 [/gpu0]",
+					Name: "GPU (GpuNvidia): NVIDIA GEFORCE GTX 1080 [/gpu0]",
 					SensorTypes: []SensorType{
 						{
 							TypeName: core.Temperature.String(),
@@ -224,11 +225,45 @@ func TestRespFromCore(t *testing.T) {
 				},
 			},
 		},
+		Warnings: []string{"get stats of laptop:8080: connection refused"},
 	}
 
 	require.Equal(t, want, fromCoreResp(coreResp))
 }
 
+func TestHardwareName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   core.Hardware
+		want string
+	}{
+		{
+			name: "no subtype",
+			in:   core.Hardware{ID: "/gpu0", Name: "NVIDIA GEFORCE GTX 1080", Type: core.GPU},
+			want: "GPU: NVIDIA GEFORCE GTX 1080 [/gpu0]",
+		},
+		{
+			name: "subtype",
+			in:   core.Hardware{ID: "/gpu1", Name: "INTEL UHD GRAPHICS 630", Type: core.GPU, Subtype: "GpuIntel"},
+			want: "GPU (GpuIntel): INTEL UHD GRAPHICS 630 [/gpu1]",
+		},
+		{
+			name: "subtype repeating type",
+			in:   core.Hardware{ID: "/cpu0", Name: "INTEL CORE I7-7700K", Type: core.CPU, Subtype: "Cpu"},
+			want: "CPU: INTEL CORE I7-7700K [/cpu0]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, hardwareName(tt.in))
+		})
+	}
+}
+
 func TestReqToCore(t *testing.T) {
 	t.Parallel()
 
@@ -272,6 +307,21 @@ func TestReqToCore(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "success with hardware IDs",
+			input: input{
+				in: GetStatsRequest{
+					Range:       "1m",
+					HardwareIDs: []string{"pc:8080/intelcpu/0", "pc:8080/gpu-nvidia/0"},
+				},
+			},
+			want: want{
+				out: core.GetStatsRequest{
+					ForRange:    time.Minute,
+					HardwareIDs: []core.HardwareID{"pc:8080/intelcpu/0", "pc:8080/gpu-nvidia/0"},
+				},
+			},
+		},
 		{
 			name: "parse error",
 			input: input{
@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -120,6 +121,10 @@ func (s *Server) getStats(ctx context.Context, conn *websocket.Conn, req core.Ge
 		}
 
 		resp, err := s.processGetStatsWithContext(ctx, req)
+		if errors.Is(err, core.ErrInvalidRequest) {
+			s.writeMessageWS(conn, websocket.TextMessage, fmt.Sprintf("bad request: %s", err.Error()))
+			break
+		}
 		if err != nil {
 			s.writeMessageWS(conn, websocket.TextMessage, fmt.Sprintf("internal error: %s", err.Error()))
 			break
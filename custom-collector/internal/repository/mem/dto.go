@@ -7,7 +7,7 @@ import (
 )
 
 type value struct {
-	Value     int64
+	Value     float64
 	Timestamp int64
 }
 
@@ -263,11 +263,6 @@ func newMemDB(name string) (*memdb.MemDB, error) {
 							Unique:  true,
 							Indexer: &memdb.IntFieldIndex{Field: "Timestamp"},
 						},
-						"value": {
-							Name:    "value",
-							Unique:  false,
-							Indexer: &memdb.IntFieldIndex{Field: "Value"},
-						},
 					},
 				},
 			},
@@ -485,7 +485,7 @@ func generateValuesForRange(from, to time.Time, step time.Duration) []core.Value
 	for t := from; t.Before(to); t = t.Add(step) {
 		values = append(values,
 			core.Value{
-				Value:     rand.N[int64](100),
+				Value:     float64(rand.N[int64](100)),
 				Timestamp: time.UnixMilli(t.UnixMilli()), // to round to milliseconds
 			},
 		)
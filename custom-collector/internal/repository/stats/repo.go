@@ -2,25 +2,251 @@ package stats
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/genvmoroz/custom-collector/internal/core"
+	"github.com/genvmoroz/win-stats/domain"
+	"github.com/genvmoroz/win-stats/picker/pkg/client"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
 )
 
-type Repo struct{}
+type (
+	Picker interface {
+		GetStats(ctx context.Context, filter client.Filter) (client.Snapshot, error)
+	}
 
-func NewRepo() *Repo {
-	return &Repo{}
+	Config struct {
+		// Hosts are the base URLs of the pickers, e.g. http://pc:8080, the stats of all of them are merged.
+		Hosts      []string      `envconfig:"APP_PICKER_HOSTS" default:"http://localhost:8080"`
+		Token      string        `envconfig:"APP_PICKER_TOKEN"`
+		Timeout    time.Duration `envconfig:"APP_PICKER_TIMEOUT" default:"5s"`
+		Attempts   uint          `envconfig:"APP_PICKER_ATTEMPTS" default:"2"`
+		RetryDelay time.Duration `envconfig:"APP_PICKER_RETRY_DELAY" default:"200ms"`
+	}
+
+	// Repo reads the stats from the pickers. The hardware and sensor IDs are prefixed with the picker host,
+	// e.g. pc:8080/intelcpu/0, so the same hardware of different hosts is told apart.
+	Repo struct {
+		hosts  []host
+		logger logrus.FieldLogger
+	}
+
+	host struct {
+		// prefix is the host and port of the picker.
+		prefix string
+		picker Picker
+	}
+)
+
+func NewRepo(cfg Config, logger logrus.FieldLogger) (*Repo, error) {
+	if lo.IsNil(logger) {
+		return nil, errors.New("logger is nil")
+	}
+	if len(cfg.Hosts) == 0 {
+		return nil, errors.New("hosts list is empty")
+	}
+	if cfg.Timeout <= 0 {
+		return nil, errors.New("timeout must be greater than 0")
+	}
+	if cfg.Attempts == 0 {
+		return nil, errors.New("attempts must be greater than 0")
+	}
+
+	pickers := make(map[string]Picker, len(cfg.Hosts))
+	for _, baseURL := range cfg.Hosts {
+		opts := []client.Option{
+			client.WithTimeout(cfg.Timeout),
+			client.WithRetries(cfg.Attempts, cfg.RetryDelay),
+		}
+		if cfg.Token != "" {
+			opts = append(opts, client.WithToken(cfg.Token))
+		}
+		picker, err := client.New(baseURL, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("create client of %s: %w", baseURL, err)
+		}
+		pickers[baseURL] = picker
+	}
+
+	return newRepo(pickers, logger)
+}
+
+// newRepo takes the pickers by their base URLs.
+func newRepo(pickers map[string]Picker, logger logrus.FieldLogger) (*Repo, error) {
+	hosts := make([]host, 0, len(pickers))
+	seen := make(map[string]struct{}, len(pickers))
+	for baseURL, picker := range pickers {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return nil, fmt.Errorf("parse host %s: %w", baseURL, err)
+		}
+		if u.Host == "" {
+			return nil, fmt.Errorf("host %s has no host name", baseURL)
+		}
+		if _, ok := seen[u.Host]; ok {
+			return nil, fmt.Errorf("host %s is duplicated", u.Host)
+		}
+		seen[u.Host] = struct{}{}
+		hosts = append(hosts, host{prefix: u.Host, picker: picker})
+	}
+
+	return &Repo{
+		hosts:  hosts,
+		logger: logger,
+	}, nil
 }
 
 func (r *Repo) GetHardware(ctx context.Context) ([]core.Hardware, error) {
-	return nil, nil
+	snapshot, err := r.GetSensorValues(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Keys(snapshot.Values), nil
+}
+
+func (r *Repo) GetSensorValues(ctx context.Context, hardwareIDs []core.HardwareID) (core.Snapshot, error) {
+	stats, errs, err := r.getStats(ctx, hardwareIDs)
+	if err != nil {
+		return core.Snapshot{}, err
+	}
+
+	out := core.Snapshot{
+		Values: make(map[core.Hardware][]core.SensorValue, len(stats)),
+		Warnings: lo.Map(errs, func(err error, _ int) string {
+			return err.Error()
+		}),
+	}
+	for hw, sensors := range stats {
+		out.Values[toCoreHardware(hw)] = lo.Map(sensors, func(s domain.Sensor, _ int) core.SensorValue {
+			return core.SensorValue{Sensor: toCoreSensor(s), Value: s.Value.Value}
+		})
+	}
+
+	return out, nil
+}
+
+// getStats reads the pickers in parallel. A failed picker is skipped, so a host that is down doesn't hide
+// the others, its error is returned along with the stats of the rest. The error is returned alone only if
+// all the requested pickers failed, or if the hardware of no picker is requested.
+func (r *Repo) getStats(ctx context.Context, hardwareIDs []core.HardwareID) (map[domain.Hardware][]domain.Sensor, []error, error) {
+	if unknown := r.unknownHardwareIDs(hardwareIDs); len(unknown) > 0 {
+		return nil, nil, fmt.Errorf("%w: hardware %v belongs to no picker host", core.ErrInvalidRequest, unknown)
+	}
+
+	var (
+		mux  sync.Mutex
+		wg   sync.WaitGroup
+		out  = make(map[domain.Hardware][]domain.Sensor)
+		errs []error
+		// requested is the number of the pickers whose hardware is requested
+		requested int
+	)
+	for _, h := range r.hosts {
+		ids, ok := h.hardwareIDs(hardwareIDs)
+		if !ok {
+			continue
+		}
+		requested++
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			snapshot, err := h.picker.GetStats(ctx, client.Filter{HardwareIDs: ids})
+
+			mux.Lock()
+			defer mux.Unlock()
+			if err != nil {
+				r.logger.WithField("Host", h.prefix).Errorf("failed to get stats: %s", err.Error())
+				errs = append(errs, fmt.Errorf("get stats of %s: %w", h.prefix, err))
+				return
+			}
+			for hw, sensors := range snapshot.Stats {
+				out[h.prefixHardware(hw)] = lo.Map(sensors, func(s domain.Sensor, _ int) domain.Sensor {
+					return h.prefixSensor(s)
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if requested > 0 && len(errs) == requested {
+		return nil, nil, errors.Join(errs...)
+	}
+
+	return out, errs, nil
+}
+
+// unknownHardwareIDs returns the requested IDs that have the prefix of no host.
+func (r *Repo) unknownHardwareIDs(requested []core.HardwareID) []core.HardwareID {
+	return lo.Filter(requested, func(id core.HardwareID, _ int) bool {
+		return !lo.ContainsBy(r.hosts, func(h host) bool {
+			_, ok := h.ownHardwareID(id)
+			return ok
+		})
+	})
+}
+
+// hardwareIDs returns the IDs of the picker hardware among the requested ones, without the prefix.
+// It returns false if the hardware of other pickers only is requested, no IDs mean all the hardware.
+func (h host) hardwareIDs(requested []core.HardwareID) ([]domain.HardwareID, bool) {
+	if len(requested) == 0 {
+		return nil, true
+	}
+
+	var out []domain.HardwareID
+	for _, id := range requested {
+		if own, ok := h.ownHardwareID(id); ok {
+			out = append(out, own)
+		}
+	}
+
+	return out, len(out) > 0
+}
+
+// ownHardwareID returns the ID without the prefix, it returns false if the hardware isn't of the picker.
+func (h host) ownHardwareID(id core.HardwareID) (domain.HardwareID, bool) {
+	own, ok := strings.CutPrefix(string(id), h.prefix)
+	if !ok || !strings.HasPrefix(own, "/") {
+		return "", false
+	}
+
+	return domain.HardwareID(own), true
+}
+
+func (h host) prefixHardware(hw domain.Hardware) domain.Hardware {
+	hw.ID = domain.HardwareID(h.prefix + string(hw.ID))
+	return hw
+}
+
+func (h host) prefixSensor(s domain.Sensor) domain.Sensor {
+	s.ID = domain.SensorID(h.prefix + string(s.ID))
+	s.HardwareID = domain.HardwareID(h.prefix + string(s.HardwareID))
+	return s
 }
 
-// todo: add ability to fetch sensors indeed for provided hardware IDs
-func (r *Repo) GetSensorsByHardware(ctx context.Context) (map[core.Hardware][]core.Sensor, error) {
-	return nil, nil
+func toCoreHardware(in domain.Hardware) core.Hardware {
+	return core.Hardware{
+		ID:      in.ID,
+		Name:    in.Name,
+		Type:    in.Type,
+		Subtype: in.Subtype,
+	}
 }
 
-func (r *Repo) GetCurrentSensorValues(ctx context.Context) (map[core.Sensor]float32, error) {
-	return nil, nil
+func toCoreSensor(in domain.Sensor) core.Sensor {
+	return core.Sensor{
+		ID:         in.ID,
+		HardwareID: in.HardwareID,
+		Name:       in.Name,
+		Type:       in.Type,
+		MaxValue:   in.Value.Max,
+	}
 }
@@ -0,0 +1,298 @@
+package stats
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/custom-collector/internal/core"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoGetSensorValues(t *testing.T) {
+	t.Parallel()
+
+	desktop, desktopCalls := newPicker(t, []pickerHardware{
+		{
+			ID: "/intelcpu/0", Name: "Intel Core i7", Type: "CPU",
+			Sensors: []pickerSensor{
+				{ID: "/intelcpu/0/temperature/0", HardwareID: "/intelcpu/0", Name: "CPU Package", Type: "Temperature", Value: pickerValue{Value: 55, Max: 90}},
+			},
+		},
+		{
+			ID: "/gpu-nvidia/0", Name: "RTX 4070", Type: "GPU", Subtype: "GpuNvidia",
+			Sensors: []pickerSensor{
+				{ID: "/gpu-nvidia/0/clock/0", HardwareID: "/gpu-nvidia/0", Name: "GPU Core", Type: "Clock", Value: pickerValue{Value: 2100, Max: 2800}},
+			},
+		},
+	})
+	laptop, laptopCalls := newPicker(t, []pickerHardware{
+		{
+			ID: "/intelcpu/0", Name: "Intel Core i5", Type: "CPU",
+			Sensors: []pickerSensor{
+				{ID: "/intelcpu/0/temperature/0", HardwareID: "/intelcpu/0", Name: "CPU Package", Type: "Temperature", Value: pickerValue{Value: 70, Max: 95}},
+			},
+		},
+	})
+
+	var (
+		desktopHost = hostOf(t, desktop)
+		laptopHost  = hostOf(t, laptop)
+
+		desktopCPU = core.Hardware{ID: core.HardwareID(desktopHost + "/intelcpu/0"), Name: "Intel Core i7", Type: core.CPU}
+		desktopGPU = core.Hardware{ID: core.HardwareID(desktopHost + "/gpu-nvidia/0"), Name: "RTX 4070", Type: core.GPU, Subtype: "GpuNvidia"}
+		laptopCPU  = core.Hardware{ID: core.HardwareID(laptopHost + "/intelcpu/0"), Name: "Intel Core i5", Type: core.CPU}
+
+		desktopCPUTemp = core.Sensor{
+			ID: core.SensorID(desktopHost + "/intelcpu/0/temperature/0"), HardwareID: desktopCPU.ID,
+			Name: "CPU Package", Type: core.Temperature, MaxValue: 90,
+		}
+		desktopGPUClock = core.Sensor{
+			ID: core.SensorID(desktopHost + "/gpu-nvidia/0/clock/0"), HardwareID: desktopGPU.ID,
+			Name: "GPU Core", Type: core.Clock, MaxValue: 2800,
+		}
+		laptopCPUTemp = core.Sensor{
+			ID: core.SensorID(laptopHost + "/intelcpu/0/temperature/0"), HardwareID: laptopCPU.ID,
+			Name: "CPU Package", Type: core.Temperature, MaxValue: 95,
+		}
+	)
+
+	repo, err := NewRepo(Config{Hosts: []string{desktop, laptop}, Timeout: time.Second, Attempts: 1}, logrus.New())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		hardwareIDs      []core.HardwareID
+		want             map[core.Hardware][]core.SensorValue
+		wantErr          error
+		wantDesktopCalls int32
+		wantLaptopCalls  int32
+	}{
+		{
+			name: "all hardware",
+			want: map[core.Hardware][]core.SensorValue{
+				desktopCPU: {{Sensor: desktopCPUTemp, Value: 55}},
+				desktopGPU: {{Sensor: desktopGPUClock, Value: 2100}},
+				laptopCPU:  {{Sensor: laptopCPUTemp, Value: 70}},
+			},
+			wantDesktopCalls: 1,
+			wantLaptopCalls:  1,
+		},
+		{
+			name:        "hardware of single host",
+			hardwareIDs: []core.HardwareID{desktopGPU.ID},
+			want: map[core.Hardware][]core.SensorValue{
+				desktopGPU: {{Sensor: desktopGPUClock, Value: 2100}},
+			},
+			wantDesktopCalls: 2,
+			wantLaptopCalls:  1,
+		},
+		{
+			name:        "hardware of both hosts",
+			hardwareIDs: []core.HardwareID{desktopCPU.ID, laptopCPU.ID},
+			want: map[core.Hardware][]core.SensorValue{
+				desktopCPU: {{Sensor: desktopCPUTemp, Value: 55}},
+				laptopCPU:  {{Sensor: laptopCPUTemp, Value: 70}},
+			},
+			wantDesktopCalls: 3,
+			wantLaptopCalls:  2,
+		},
+		{
+			name:             "hardware of unknown host",
+			hardwareIDs:      []core.HardwareID{"server:8080/intelcpu/0"},
+			wantErr:          core.ErrInvalidRequest,
+			wantDesktopCalls: 3,
+			wantLaptopCalls:  2,
+		},
+		{
+			name:             "hardware of known and unknown hosts",
+			hardwareIDs:      []core.HardwareID{desktopCPU.ID, "/intelcpu/0"},
+			wantErr:          core.ErrInvalidRequest,
+			wantDesktopCalls: 3,
+			wantLaptopCalls:  2,
+		},
+	}
+	// the cases share the call counters, so they are run one by one
+	for _, tt := range tests {
+		got, err := repo.GetSensorValues(context.Background(), tt.hardwareIDs)
+		require.Equal(t, tt.wantDesktopCalls, desktopCalls.Load(), tt.name)
+		require.Equal(t, tt.wantLaptopCalls, laptopCalls.Load(), tt.name)
+		if tt.wantErr != nil {
+			require.ErrorIs(t, err, tt.wantErr, tt.name)
+			continue
+		}
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.want, got.Values, tt.name)
+		require.Empty(t, got.Warnings, tt.name)
+	}
+}
+
+func TestRepoHostDown(t *testing.T) {
+	t.Parallel()
+
+	up, _ := newPicker(t, []pickerHardware{{ID: "/ram", Name: "Memory", Type: "Memory"}})
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(down.Close)
+	hanging := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	t.Cleanup(hanging.Close)
+
+	tests := []struct {
+		name  string
+		hosts []string
+		want  map[core.Hardware][]core.SensorValue
+		// wantWarnings are the hosts the warnings are about
+		wantWarnings []string
+		wantErr      bool
+	}{
+		{
+			name:  "other host is down",
+			hosts: []string{up, down.URL, hanging.URL},
+			want: map[core.Hardware][]core.SensorValue{
+				{ID: core.HardwareID(hostOf(t, up) + "/ram"), Name: "Memory", Type: core.Memory}: {},
+			},
+			wantWarnings: []string{hostOf(t, down.URL), hostOf(t, hanging.URL)},
+		},
+		{
+			name:    "all hosts are down",
+			hosts:   []string{down.URL, hanging.URL},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo, err := NewRepo(
+				Config{Hosts: tt.hosts, Timeout: 50 * time.Millisecond, Attempts: 2, RetryDelay: time.Millisecond},
+				logrus.New(),
+			)
+			require.NoError(t, err)
+
+			got, err := repo.GetSensorValues(context.Background(), nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.Values)
+			require.Len(t, got.Warnings, len(tt.wantWarnings))
+			for _, host := range tt.wantWarnings {
+				require.True(t, slices.ContainsFunc(got.Warnings, func(warning string) bool {
+					return strings.Contains(warning, host)
+				}), "no warning about %s in %v", host, got.Warnings)
+			}
+		})
+	}
+}
+
+func TestNewRepo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{
+			name:    "no hosts",
+			cfg:     Config{Timeout: time.Second, Attempts: 1},
+			wantErr: "hosts list is empty",
+		},
+		{
+			name:    "no timeout",
+			cfg:     Config{Hosts: []string{"http://pc:8080"}, Attempts: 1},
+			wantErr: "timeout must be greater than 0",
+		},
+		{
+			name:    "duplicated host",
+			cfg:     Config{Hosts: []string{"http://pc:8080", "https://pc:8080"}, Timeout: time.Second, Attempts: 1},
+			wantErr: "host pc:8080 is duplicated",
+		},
+		{
+			name:    "no host name",
+			cfg:     Config{Hosts: []string{"pc"}, Timeout: time.Second, Attempts: 1},
+			wantErr: "host pc has no host name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewRepo(tt.cfg, logrus.New())
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+type (
+	pickerHardware struct {
+		ID      string
+		Name    string
+		Type    string
+		Subtype string `json:",omitempty"`
+		Sensors []pickerSensor
+	}
+
+	pickerSensor struct {
+		ID         string
+		HardwareID string
+		Name       string
+		Type       string
+		Value      pickerValue
+	}
+
+	pickerValue struct {
+		Value float64
+		Max   float64
+	}
+)
+
+// newPicker starts the stand-in of picker serving /v2/stats, it filters the hardware by the hardwareId parameters
+// and counts the calls.
+func newPicker(t *testing.T, hardware []pickerHardware) (string, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/stats" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		calls.Add(1)
+
+		ids := r.URL.Query()["hardwareId"]
+		selected := make([]pickerHardware, 0, len(hardware))
+		for _, hw := range hardware {
+			if len(ids) == 0 || slices.Contains(ids, hw.ID) {
+				selected = append(selected, hw)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"Hardware": selected})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv.URL, &calls
+}
+
+func hostOf(t *testing.T, baseURL string) string {
+	t.Helper()
+
+	u, err := url.Parse(baseURL)
+	require.NoError(t, err)
+
+	return u.Host
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+//go:generate stringer -output=enum_strings.go -type=HardwareType,SensorType,Unit
+
+type HardwareType int
+
+const (
+	UnknownHardwareType HardwareType = iota
+	SuperIO
+	CPU
+	GPU
+	TBalancer
+	HeatMaster
+	HDD
+	RAM
+	Network
+	Memory
+	Storage
+	Motherboard
+	Battery
+	Cooler
+	EmbeddedController
+	PSU
+)
+
+type SensorType int
+
+const (
+	UnknownSensorType SensorType = iota
+	Voltage
+	Clock
+	Temperature
+	Load
+	Fan
+	Flow
+	Control
+	Level
+	Power
+	SmallData
+	Throughput
+	Data
+	Factor
+	Energy
+	Current
+	Frequency
+	TimeSpan
+	Noise
+	Conductivity
+	Humidity
+)
+
+type Unit int
+
+const (
+	UnknownUnit Unit = iota
+	Volt
+	Megahertz
+	Celsius
+	Percentage
+	RevolutionsPerMinute
+	LitersPerHour
+	Watts
+	Gigabytes
+	Megabytes
+	KilobytesPerSecond
+	Amperes
+	MilliwattHours
+	BytesPerSecond
+	MegabytesPerSecond
+	Terabytes
+	Fahrenheit
+	Gigahertz
+	// Ratio is the unit of the dimensionless values, e.g. the Factor sensors.
+	Ratio
+	Hertz
+	Seconds
+	// Decibels are A-weighted, as the noise is reported by LibreHardwareMonitor.
+	Decibels
+	MicrosiemensPerCentimeter
+)
+
+// ParseHardwareType returns the hardware type by its name, the name is case-insensitive.
+func ParseHardwareType(name string) (HardwareType, error) {
+	for t := UnknownHardwareType; t <= PSU; t++ {
+		if strings.EqualFold(t.String(), name) {
+			return t, nil
+		}
+	}
+	return UnknownHardwareType, fmt.Errorf("unknown hardware type: %s", name)
+}
+
+// ParseSensorType returns the sensor type by its name, the name is case-insensitive.
+func ParseSensorType(name string) (SensorType, error) {
+	for t := UnknownSensorType; t <= Humidity; t++ {
+		if strings.EqualFold(t.String(), name) {
+			return t, nil
+		}
+	}
+	return UnknownSensorType, fmt.Errorf("unknown sensor type: %s", name)
+}
+
+// ParseUnit returns the unit by its name, the name is case-insensitive.
+func ParseUnit(name string) (Unit, error) {
+	for u := UnknownUnit; u <= MicrosiemensPerCentimeter; u++ {
+		if strings.EqualFold(u.String(), name) {
+			return u, nil
+		}
+	}
+	return UnknownUnit, fmt.Errorf("unknown unit: %s", name)
+}
@@ -1,6 +1,6 @@
 // Code generated by "stringer -output=enum_strings.go -type=HardwareType,SensorType,Unit"; DO NOT EDIT.
 
-package core
+package domain
 
 import "strconv"
 
@@ -21,11 +21,14 @@ func _() {
 	_ = x[Storage-10]
 	_ = x[Motherboard-11]
 	_ = x[Battery-12]
+	_ = x[Cooler-13]
+	_ = x[EmbeddedController-14]
+	_ = x[PSU-15]
 }
 
-const _HardwareType_name = "UnknownHardwareTypeSuperIOCPUGPUTBalancerHeatMasterHDDRAMNetworkMemoryStorageMotherboardBattery"
+const _HardwareType_name = "UnknownHardwareTypeSuperIOCPUGPUTBalancerHeatMasterHDDRAMNetworkMemoryStorageMotherboardBatteryCoolerEmbeddedControllerPSU"
 
-var _HardwareType_index = [...]uint8{0, 19, 26, 29, 32, 41, 51, 54, 57, 64, 70, 77, 88, 95}
+var _HardwareType_index = [...]uint8{0, 19, 26, 29, 32, 41, 51, 54, 57, 64, 70, 77, 88, 95, 101, 119, 122}
 
 func (i HardwareType) String() string {
 	if i < 0 || i >= HardwareType(len(_HardwareType_index)-1) {
@@ -53,11 +56,16 @@ func _() {
 	_ = x[Factor-13]
 	_ = x[Energy-14]
 	_ = x[Current-15]
+	_ = x[Frequency-16]
+	_ = x[TimeSpan-17]
+	_ = x[Noise-18]
+	_ = x[Conductivity-19]
+	_ = x[Humidity-20]
 }
 
-const _SensorType_name = "UnknownSensorTypeVoltageClockTemperatureLoadFanFlowControlLevelPowerSmallDataThroughputDataFactorEnergyCurrent"
+const _SensorType_name = "UnknownSensorTypeVoltageClockTemperatureLoadFanFlowControlLevelPowerSmallDataThroughputDataFactorEnergyCurrentFrequencyTimeSpanNoiseConductivityHumidity"
 
-var _SensorType_index = [...]uint8{0, 17, 24, 29, 40, 44, 47, 51, 58, 63, 68, 77, 87, 91, 97, 103, 110}
+var _SensorType_index = [...]uint8{0, 17, 24, 29, 40, 44, 47, 51, 58, 63, 68, 77, 87, 91, 97, 103, 110, 119, 127, 132, 144, 152}
 
 func (i SensorType) String() string {
 	if i < 0 || i >= SensorType(len(_SensorType_index)-1) {
@@ -80,11 +88,23 @@ func _() {
 	_ = x[Gigabytes-8]
 	_ = x[Megabytes-9]
 	_ = x[KilobytesPerSecond-10]
+	_ = x[Amperes-11]
+	_ = x[MilliwattHours-12]
+	_ = x[BytesPerSecond-13]
+	_ = x[MegabytesPerSecond-14]
+	_ = x[Terabytes-15]
+	_ = x[Fahrenheit-16]
+	_ = x[Gigahertz-17]
+	_ = x[Ratio-18]
+	_ = x[Hertz-19]
+	_ = x[Seconds-20]
+	_ = x[Decibels-21]
+	_ = x[MicrosiemensPerCentimeter-22]
 }
 
-const _Unit_name = "UnknownUnitVoltMegahertzCelsiusPercentageRevolutionsPerMinuteLitersPerHourWattsGigabytesMegabytesKilobytesPerSecond"
+const _Unit_name = "UnknownUnitVoltMegahertzCelsiusPercentageRevolutionsPerMinuteLitersPerHourWattsGigabytesMegabytesKilobytesPerSecondAmperesMilliwattHoursBytesPerSecondMegabytesPerSecondTerabytesFahrenheitGigahertzRatioHertzSecondsDecibelsMicrosiemensPerCentimeter"
 
-var _Unit_index = [...]uint8{0, 11, 15, 24, 31, 41, 61, 74, 79, 88, 97, 115}
+var _Unit_index = [...]uint8{0, 11, 15, 24, 31, 41, 61, 74, 79, 88, 97, 115, 122, 136, 150, 168, 177, 187, 196, 201, 206, 213, 221, 246}
 
 func (i Unit) String() string {
 	if i < 0 || i >= Unit(len(_Unit_index)-1) {
@@ -0,0 +1,119 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/genvmoroz/win-stats/domain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseHardwareType covers every type picker reports for the LibreHardwareMonitor catalogue.
+func TestParseHardwareType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want domain.HardwareType
+	}{
+		{name: "Motherboard", want: domain.Motherboard},
+		{name: "SuperIO", want: domain.SuperIO},
+		{name: "CPU", want: domain.CPU},
+		{name: "GPU", want: domain.GPU},
+		{name: "TBalancer", want: domain.TBalancer},
+		{name: "HeatMaster", want: domain.HeatMaster},
+		{name: "HDD", want: domain.HDD},
+		{name: "RAM", want: domain.RAM},
+		{name: "Network", want: domain.Network},
+		{name: "Memory", want: domain.Memory},
+		{name: "Storage", want: domain.Storage},
+		{name: "Battery", want: domain.Battery},
+		{name: "Cooler", want: domain.Cooler},
+		{name: "EmbeddedController", want: domain.EmbeddedController},
+		{name: "PSU", want: domain.PSU},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := domain.ParseHardwareType(tt.name)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.name, got.String())
+		})
+	}
+
+	_, err := domain.ParseHardwareType("Qpu")
+	require.Error(t, err)
+}
+
+func TestParseSensorType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		want     domain.SensorType
+		wantUnit domain.Unit
+	}{
+		{name: "Voltage", want: domain.Voltage, wantUnit: domain.Volt},
+		{name: "Current", want: domain.Current, wantUnit: domain.Amperes},
+		{name: "Power", want: domain.Power, wantUnit: domain.Watts},
+		{name: "Clock", want: domain.Clock, wantUnit: domain.Megahertz},
+		{name: "Temperature", want: domain.Temperature, wantUnit: domain.Celsius},
+		{name: "Load", want: domain.Load, wantUnit: domain.Percentage},
+		{name: "Frequency", want: domain.Frequency, wantUnit: domain.Hertz},
+		{name: "Fan", want: domain.Fan, wantUnit: domain.RevolutionsPerMinute},
+		{name: "Flow", want: domain.Flow, wantUnit: domain.LitersPerHour},
+		{name: "Control", want: domain.Control, wantUnit: domain.Percentage},
+		{name: "Level", want: domain.Level, wantUnit: domain.Percentage},
+		{name: "Factor", want: domain.Factor, wantUnit: domain.Ratio},
+		{name: "Data", want: domain.Data, wantUnit: domain.Gigabytes},
+		{name: "SmallData", want: domain.SmallData, wantUnit: domain.Megabytes},
+		{name: "Throughput", want: domain.Throughput, wantUnit: domain.BytesPerSecond},
+		{name: "TimeSpan", want: domain.TimeSpan, wantUnit: domain.Seconds},
+		{name: "Energy", want: domain.Energy, wantUnit: domain.MilliwattHours},
+		{name: "Noise", want: domain.Noise, wantUnit: domain.Decibels},
+		{name: "Conductivity", want: domain.Conductivity, wantUnit: domain.MicrosiemensPerCentimeter},
+		{name: "Humidity", want: domain.Humidity, wantUnit: domain.Percentage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := domain.ParseSensorType(tt.name)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.name, got.String())
+			require.Equal(t, tt.wantUnit, got.Unit())
+		})
+	}
+
+	_, err := domain.ParseSensorType("Qubits")
+	require.Error(t, err)
+}
+
+func TestParseUnit(t *testing.T) {
+	t.Parallel()
+
+	for u := domain.UnknownUnit; u <= domain.MicrosiemensPerCentimeter; u++ {
+		got, err := domain.ParseUnit(strings.ToLower(u.String()))
+		require.NoError(t, err)
+		require.Equal(t, u, got)
+	}
+
+	_, err := domain.ParseUnit("Parsecs")
+	require.Error(t, err)
+}
+
+func TestParseStatus(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []domain.Status{domain.StatusOK, domain.StatusWarn, domain.StatusCrit} {
+		got, err := domain.ParseStatus(s.String())
+		require.NoError(t, err)
+		require.Equal(t, s, got)
+	}
+
+	_, err := domain.ParseStatus("FINE")
+	require.Error(t, err)
+}
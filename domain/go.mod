@@ -0,0 +1,16 @@
+module github.com/genvmoroz/win-stats/domain
+
+go 1.24
+
+require (
+	github.com/oapi-codegen/runtime v1.1.2
+	github.com/stretchr/testify v1.10.0
+)
+
+require (
+	github.com/apapsch/go-jsonmerge/v2 v2.0.0 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/google/uuid v1.5.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,49 @@
+// Package domain is the model of the hardware stats shared by picker and the collectors.
+package domain
+
+import (
+	"time"
+)
+
+type (
+	HardwareID string
+	SensorID   string
+)
+
+type Hardware struct {
+	ID HardwareID
+	// ParentID is the hardware this one is attached to, e.g. the motherboard of a SuperIO chip.
+	// It is empty for the top-level hardware.
+	ParentID HardwareID
+	Name     string
+	Type     HardwareType
+	// RawType is the type reported by the backend, it is set only if the type is unknown to picker.
+	RawType string
+	// Subtype tells apart the hardware of the same type, e.g. the integrated and the discrete GPUs.
+	// It is the backend's own type, e.g. GpuNvidia for the LibreHardwareMonitor, or the driver for hwmon.
+	Subtype string
+}
+
+type Sensor struct {
+	ID         SensorID
+	HardwareID HardwareID
+	Name       string
+	Type       SensorType
+	// RawType is the type reported by the backend, it is set only if the type is unknown to picker.
+	RawType string
+	// Index is the position of the sensor among the sensors of the same type of the hardware.
+	Index int
+	// Unit is the unit of the value, picker sets it according to the requested unit preferences.
+	Unit Unit
+	// Status is judged by the picker thresholds.
+	Status Status
+	Value  SensorValue
+}
+
+type SensorValue struct {
+	Value float64
+	// Min and Max are the lowest and highest values observed by the backend since it was started.
+	Min       float64
+	Max       float64
+	Timestamp time.Time
+}
@@ -0,0 +1,2058 @@
+// Package pickerapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package pickerapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Defines values for HealthStatus.
+const (
+	CRIT HealthStatus = "CRIT"
+	OK   HealthStatus = "OK"
+	WARN HealthStatus = "WARN"
+)
+
+// Defines values for StreamStatsParamsMode.
+const (
+	Changes  StreamStatsParamsMode = "changes"
+	Snapshot StreamStatsParamsMode = "snapshot"
+)
+
+// Defines values for GetStatsV2ParamsLayout.
+const (
+	Flat GetStatsV2ParamsLayout = "flat"
+	Tree GetStatsV2ParamsLayout = "tree"
+)
+
+// Error Describes an error response
+type Error struct {
+	Message    *string `json:"Message,omitempty"`
+	StatusCode *int64  `json:"StatusCode,omitempty"`
+}
+
+// Hardware Describes a hardware component
+type Hardware struct {
+	ID      *string   `json:"ID,omitempty"`
+	Name    *string   `json:"Name,omitempty"`
+	Sensors *[]Sensor `json:"Sensors,omitempty"`
+
+	// Subtype Type reported by the sensor backend, e.g. GpuNvidia, tells apart the hardware of the same Type
+	Subtype *string `json:"Subtype,omitempty"`
+
+	// Type One of Motherboard, SuperIO, CPU, GPU, Memory, RAM, Storage, HDD, Network, Cooler, EmbeddedController,
+	// PSU, Battery, TBalancer, HeatMaster or UnknownHardwareType
+	Type *string `json:"Type,omitempty"`
+}
+
+// HardwareStatus Describes the health of a hardware component
+type HardwareStatus struct {
+	ID   *string `json:"ID,omitempty"`
+	Name *string `json:"Name,omitempty"`
+
+	// Sensors The sensors whose status is not OK
+	Sensors *[]SensorStatus `json:"Sensors,omitempty"`
+
+	// Status Health judged by the sensor thresholds, the sensor without a matching threshold is OK
+	Status *HealthStatus `json:"Status,omitempty"`
+	Type   *string       `json:"Type,omitempty"`
+}
+
+// HardwareV2 Describes a hardware component
+type HardwareV2 struct {
+	// Children The nested hardware, returned only for the tree layout
+	Children *[]HardwareV2 `json:"Children,omitempty"`
+	ID       *string       `json:"ID,omitempty"`
+	Name     *string       `json:"Name,omitempty"`
+
+	// ParentID ID of the hardware this one is attached to, absent for the top-level hardware
+	ParentID *string `json:"ParentID,omitempty"`
+
+	// RawType Type reported by the sensor backend, present only if the Type is Unknown
+	RawType *string     `json:"RawType,omitempty"`
+	Sensors *[]SensorV2 `json:"Sensors,omitempty"`
+
+	// Subtype Type reported by the sensor backend, e.g. GpuNvidia, tells apart the hardware of the same Type
+	Subtype *string `json:"Subtype,omitempty"`
+
+	// Type One of Motherboard, SuperIO, CPU, GPU, Memory, RAM, Storage, HDD, Network, Cooler, EmbeddedController,
+	// PSU, Battery, TBalancer, HeatMaster or UnknownHardwareType
+	Type *string `json:"Type,omitempty"`
+}
+
+// HealthStatus Health judged by the sensor thresholds, the sensor without a matching threshold is OK
+type HealthStatus string
+
+// HostStatus Describes a response to the GetStatus endpoint
+type HostStatus struct {
+	Hardware *[]HardwareStatus `json:"Hardware,omitempty"`
+
+	// Status Health judged by the sensor thresholds, the sensor without a matching threshold is OK
+	Status *HealthStatus `json:"Status,omitempty"`
+}
+
+// Sensor Describes a sensor
+type Sensor struct {
+	ID *string `json:"ID,omitempty"`
+
+	// Index Position of the sensor among the sensors of the same type of the hardware
+	Index *int    `json:"Index,omitempty"`
+	Name  *string `json:"Name,omitempty"`
+
+	// RawType Type reported by the sensor backend, present only if the Type is Unknown
+	RawType *string `json:"RawType,omitempty"`
+
+	// Status Health judged by the sensor thresholds, the sensor without a matching threshold is OK
+	Status *HealthStatus `json:"Status,omitempty"`
+
+	// Type One of Voltage, Current, Power, Clock, Temperature, Load, Frequency, Fan, Flow, Control, Level, Factor,
+	// Data, SmallData, Throughput, TimeSpan, Energy, Noise, Conductivity, Humidity or UnknownSensorType
+	Type *string `json:"Type,omitempty"`
+
+	// Unit Unit of the value, e.g. Celsius or Megahertz. UnknownUnit is returned for the sensors of an unknown type
+	Unit *string `json:"Unit,omitempty"`
+
+	// Value Describes a sensor value
+	Value *SensorValue `json:"Value,omitempty"`
+}
+
+// SensorHistory Describes the recorded values of a sensor
+type SensorHistory struct {
+	// Sensor Describes a sensor
+	Sensor *SensorV2 `json:"Sensor,omitempty"`
+
+	// Values The recorded values, oldest first
+	Values *[]SensorValueV2 `json:"Values,omitempty"`
+}
+
+// SensorStatus Describes the health of a sensor
+type SensorStatus struct {
+	// Reason Which threshold the value crossed, e.g. value 96 is above the critical threshold 95
+	Reason *string `json:"Reason,omitempty"`
+
+	// Sensor Describes a sensor
+	Sensor *SensorV2 `json:"Sensor,omitempty"`
+
+	// Status Health judged by the sensor thresholds, the sensor without a matching threshold is OK
+	Status *HealthStatus `json:"Status,omitempty"`
+}
+
+// SensorV2 Describes a sensor
+type SensorV2 struct {
+	HardwareID *string `json:"HardwareID,omitempty"`
+	ID         *string `json:"ID,omitempty"`
+
+	// Index Position of the sensor among the sensors of the same type of the hardware
+	Index *int    `json:"Index,omitempty"`
+	Name  *string `json:"Name,omitempty"`
+
+	// RawType Type reported by the sensor backend, present only if the Type is Unknown
+	RawType *string `json:"RawType,omitempty"`
+
+	// Status Health judged by the sensor thresholds, the sensor without a matching threshold is OK
+	Status *HealthStatus `json:"Status,omitempty"`
+
+	// Type One of Voltage, Current, Power, Clock, Temperature, Load, Frequency, Fan, Flow, Control, Level, Factor,
+	// Data, SmallData, Throughput, TimeSpan, Energy, Noise, Conductivity, Humidity or UnknownSensorType
+	Type *string `json:"Type,omitempty"`
+
+	// Unit Unit of the value, e.g. Celsius or Megahertz. UnknownUnit is returned for the sensors of an unknown type
+	Unit *string `json:"Unit,omitempty"`
+
+	// Value Describes a sensor value
+	Value *SensorValueV2 `json:"Value,omitempty"`
+}
+
+// SensorValue Describes a sensor value
+type SensorValue struct {
+	// Max Highest value observed since the sensor backend was started
+	Max *int64 `json:"Max,omitempty"`
+
+	// Min Lowest value observed since the sensor backend was started
+	Min       *int64 `json:"Min,omitempty"`
+	Timestamp *int64 `json:"Timestamp,omitempty"`
+	Value     *int64 `json:"Value,omitempty"`
+}
+
+// SensorValueV2 Describes a sensor value
+type SensorValueV2 struct {
+	// Max Highest value observed since the sensor backend was started
+	Max *float64 `json:"Max,omitempty"`
+
+	// Min Lowest value observed since the sensor backend was started
+	Min       *float64 `json:"Min,omitempty"`
+	Timestamp *int64   `json:"Timestamp,omitempty"`
+	Value     *float64 `json:"Value,omitempty"`
+}
+
+// Stats Describes a response to the GetStats endpoint, the values are rounded to integers
+type Stats struct {
+	Hardware *[]Hardware `json:"Hardware,omitempty"`
+
+	// Warnings Issues that didn't fail the request, e.g. the hardware or sensors of an unknown type
+	Warnings *[]string `json:"Warnings,omitempty"`
+}
+
+// StatsHistory Describes a response to the GetStatsHistory endpoint
+type StatsHistory struct {
+	Sensors *[]SensorHistory `json:"Sensors,omitempty"`
+}
+
+// StatsV2 Describes a response to the GetStatsV2 endpoint
+type StatsV2 struct {
+	// Age Seconds since the stats were read from the sensor backend. Absent from the stream events.
+	Age      *float64      `json:"Age,omitempty"`
+	Hardware *[]HardwareV2 `json:"Hardware,omitempty"`
+
+	// Stale Whether the stats are older than APP_STATS_CACHE_RETENTION: they are served while being refreshed
+	// in the background, or because the refresh failed. The failed refresh is returned as an error once
+	// the stats are older than APP_STATS_CACHE_MAX_FALLBACK_AGE. Absent from the stream events.
+	Stale *bool `json:"Stale,omitempty"`
+
+	// Warnings Issues that didn't fail the request, e.g. the hardware or sensors of an unknown type
+	Warnings *[]string `json:"Warnings,omitempty"`
+}
+
+// HardwareIDFilter defines model for HardwareIDFilter.
+type HardwareIDFilter = []string
+
+// HardwareIDPath defines model for HardwareIDPath.
+type HardwareIDPath = string
+
+// HardwareTypeFilter defines model for HardwareTypeFilter.
+type HardwareTypeFilter = []string
+
+// NameFilter defines model for NameFilter.
+type NameFilter = []string
+
+// SensorIDFilter defines model for SensorIDFilter.
+type SensorIDFilter = []string
+
+// SensorIDPath defines model for SensorIDPath.
+type SensorIDPath = string
+
+// SensorTypeFilter defines model for SensorTypeFilter.
+type SensorTypeFilter = []string
+
+// UnitsPreference defines model for UnitsPreference.
+type UnitsPreference = []string
+
+// GetStatsParams defines parameters for GetStats.
+type GetStatsParams struct {
+	// HardwareType Returns only the hardware of the types, e.g. CPU or GPU. Repeat the parameter to pass several types.
+	HardwareType *HardwareTypeFilter `form:"hardwareType,omitempty" json:"hardwareType,omitempty"`
+
+	// SensorType Returns only the sensors of the types, e.g. Temperature or Fan. Repeat the parameter to pass several types.
+	SensorType *SensorTypeFilter `form:"sensorType,omitempty" json:"sensorType,omitempty"`
+
+	// HardwareId Returns only the hardware with the IDs. Repeat the parameter to pass several IDs.
+	HardwareId *HardwareIDFilter `form:"hardwareId,omitempty" json:"hardwareId,omitempty"`
+
+	// SensorId Returns only the sensors with the IDs. Repeat the parameter to pass several IDs.
+	SensorId *SensorIDFilter `form:"sensorId,omitempty" json:"sensorId,omitempty"`
+
+	// Name Returns only the sensors whose names match any of the patterns, case-insensitive.
+	// The pattern supports the wildcards '*' matching any sequence of characters and '?' matching a single one.
+	// Repeat the parameter to pass several patterns.
+	Name *NameFilter `form:"name,omitempty" json:"name,omitempty"`
+
+	// Units Converts the sensor values to the preferred units: celsius or fahrenheit for the temperature,
+	// mhz or ghz for the clock, bytes/s, kb/s or mb/s for the throughput, human to show the data sizes
+	// in the largest of MB, GB and TB the value is at least 1 in. The names are case-insensitive.
+	// Repeat the parameter to pass several preferences.
+	Units *UnitsPreference `form:"units,omitempty" json:"units,omitempty"`
+}
+
+// GetStatsHistoryParams defines parameters for GetStatsHistory.
+type GetStatsHistoryParams struct {
+	// SensorId The IDs of the sensors. Repeat the parameter to pass several IDs.
+	SensorId []string `form:"sensorId" json:"sensorId"`
+
+	// Range How far back the values are returned as a duration, e.g. 90s or 5m. All the recorded values are returned if it is absent.
+	Range *string `form:"range,omitempty" json:"range,omitempty"`
+
+	// Units Converts the sensor values to the preferred units: celsius or fahrenheit for the temperature,
+	// mhz or ghz for the clock, bytes/s, kb/s or mb/s for the throughput, human to show the data sizes
+	// in the largest of MB, GB and TB the value is at least 1 in. The names are case-insensitive.
+	// Repeat the parameter to pass several preferences.
+	Units *UnitsPreference `form:"units,omitempty" json:"units,omitempty"`
+}
+
+// StreamStatsParams defines parameters for StreamStats.
+type StreamStatsParams struct {
+	// Interval The minimal number of seconds between the events
+	Interval *int `form:"interval,omitempty" json:"interval,omitempty"`
+
+	// Mode Whether every event carries all the stats or only the changed sensors
+	Mode *StreamStatsParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// HardwareType Returns only the hardware of the types, e.g. CPU or GPU. Repeat the parameter to pass several types.
+	HardwareType *HardwareTypeFilter `form:"hardwareType,omitempty" json:"hardwareType,omitempty"`
+
+	// SensorType Returns only the sensors of the types, e.g. Temperature or Fan. Repeat the parameter to pass several types.
+	SensorType *SensorTypeFilter `form:"sensorType,omitempty" json:"sensorType,omitempty"`
+
+	// HardwareId Returns only the hardware with the IDs. Repeat the parameter to pass several IDs.
+	HardwareId *HardwareIDFilter `form:"hardwareId,omitempty" json:"hardwareId,omitempty"`
+
+	// SensorId Returns only the sensors with the IDs. Repeat the parameter to pass several IDs.
+	SensorId *SensorIDFilter `form:"sensorId,omitempty" json:"sensorId,omitempty"`
+
+	// Name Returns only the sensors whose names match any of the patterns, case-insensitive.
+	// The pattern supports the wildcards '*' matching any sequence of characters and '?' matching a single one.
+	// Repeat the parameter to pass several patterns.
+	Name *NameFilter `form:"name,omitempty" json:"name,omitempty"`
+
+	// Units Converts the sensor values to the preferred units: celsius or fahrenheit for the temperature,
+	// mhz or ghz for the clock, bytes/s, kb/s or mb/s for the throughput, human to show the data sizes
+	// in the largest of MB, GB and TB the value is at least 1 in. The names are case-insensitive.
+	// Repeat the parameter to pass several preferences.
+	Units *UnitsPreference `form:"units,omitempty" json:"units,omitempty"`
+
+	// LastEventID ID of the last event received before reconnecting
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// StreamStatsParamsMode defines parameters for StreamStats.
+type StreamStatsParamsMode string
+
+// GetStatsV2Params defines parameters for GetStatsV2.
+type GetStatsV2Params struct {
+	// Layout The shape of the hardware list: flat returns all the hardware as siblings,
+	// tree nests the hardware under its parent the way LibreHardwareMonitor shows it.
+	Layout *GetStatsV2ParamsLayout `form:"layout,omitempty" json:"layout,omitempty"`
+
+	// HardwareType Returns only the hardware of the types, e.g. CPU or GPU. Repeat the parameter to pass several types.
+	HardwareType *HardwareTypeFilter `form:"hardwareType,omitempty" json:"hardwareType,omitempty"`
+
+	// SensorType Returns only the sensors of the types, e.g. Temperature or Fan. Repeat the parameter to pass several types.
+	SensorType *SensorTypeFilter `form:"sensorType,omitempty" json:"sensorType,omitempty"`
+
+	// HardwareId Returns only the hardware with the IDs. Repeat the parameter to pass several IDs.
+	HardwareId *HardwareIDFilter `form:"hardwareId,omitempty" json:"hardwareId,omitempty"`
+
+	// SensorId Returns only the sensors with the IDs. Repeat the parameter to pass several IDs.
+	SensorId *SensorIDFilter `form:"sensorId,omitempty" json:"sensorId,omitempty"`
+
+	// Name Returns only the sensors whose names match any of the patterns, case-insensitive.
+	// The pattern supports the wildcards '*' matching any sequence of characters and '?' matching a single one.
+	// Repeat the parameter to pass several patterns.
+	Name *NameFilter `form:"name,omitempty" json:"name,omitempty"`
+
+	// Units Converts the sensor values to the preferred units: celsius or fahrenheit for the temperature,
+	// mhz or ghz for the clock, bytes/s, kb/s or mb/s for the throughput, human to show the data sizes
+	// in the largest of MB, GB and TB the value is at least 1 in. The names are case-insensitive.
+	// Repeat the parameter to pass several preferences.
+	Units *UnitsPreference `form:"units,omitempty" json:"units,omitempty"`
+}
+
+// GetStatsV2ParamsLayout defines parameters for GetStatsV2.
+type GetStatsV2ParamsLayout string
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// HealthCheck request
+	HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStats request
+	GetStats(ctx context.Context, params *GetStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatsHistory request
+	GetStatsHistory(ctx context.Context, params *GetStatsHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamStats request
+	StreamStats(ctx context.Context, params *StreamStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatus request
+	GetStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListHardwareV2 request
+	ListHardwareV2(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHardwareV2 request
+	GetHardwareV2(ctx context.Context, id HardwareIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListHardwareSensorsV2 request
+	ListHardwareSensorsV2(ctx context.Context, id HardwareIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSensorV2 request
+	GetSensorV2(ctx context.Context, id SensorIDPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatsV2 request
+	GetStatsV2(ctx context.Context, params *GetStatsV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthCheckRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStats(ctx context.Context, params *GetStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatsHistory(ctx context.Context, params *GetStatsHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsHistoryRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamStats(ctx context.Context, params *StreamStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListHardwareV2(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListHardwareV2Request(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHardwareV2(ctx context.Context, id HardwareIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHardwareV2Request(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListHardwareSensorsV2(ctx context.Context, id HardwareIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListHardwareSensorsV2Request(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSensorV2(ctx context.Context, id SensorIDPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSensorV2Request(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatsV2(ctx context.Context, params *GetStatsV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsV2Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewHealthCheckRequest generates requests for HealthCheck
+func NewHealthCheckRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatsRequest generates requests for GetStats
+func NewGetStatsRequest(server string, params *GetStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.HardwareType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hardwareType", runtime.ParamLocationQuery, *params.HardwareType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SensorType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sensorType", runtime.ParamLocationQuery, *params.SensorType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HardwareId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hardwareId", runtime.ParamLocationQuery, *params.HardwareId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SensorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sensorId", runtime.ParamLocationQuery, *params.SensorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Units != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "units", runtime.ParamLocationQuery, *params.Units); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatsHistoryRequest generates requests for GetStatsHistory
+func NewGetStatsHistoryRequest(server string, params *GetStatsHistoryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats/history")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sensorId", runtime.ParamLocationQuery, params.SensorId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Range != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "range", runtime.ParamLocationQuery, *params.Range); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Units != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "units", runtime.ParamLocationQuery, *params.Units); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamStatsRequest generates requests for StreamStats
+func NewStreamStatsRequest(server string, params *StreamStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Interval != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "interval", runtime.ParamLocationQuery, *params.Interval); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Mode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mode", runtime.ParamLocationQuery, *params.Mode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HardwareType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hardwareType", runtime.ParamLocationQuery, *params.HardwareType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SensorType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sensorType", runtime.ParamLocationQuery, *params.SensorType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HardwareId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hardwareId", runtime.ParamLocationQuery, *params.HardwareId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SensorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sensorId", runtime.ParamLocationQuery, *params.SensorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Units != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "units", runtime.ParamLocationQuery, *params.Units); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetStatusRequest generates requests for GetStatus
+func NewGetStatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListHardwareV2Request generates requests for ListHardwareV2
+func NewListHardwareV2Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/hardware")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHardwareV2Request generates requests for GetHardwareV2
+func NewGetHardwareV2Request(server string, id HardwareIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/hardware/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListHardwareSensorsV2Request generates requests for ListHardwareSensorsV2
+func NewListHardwareSensorsV2Request(server string, id HardwareIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/hardware/%s/sensors", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSensorV2Request generates requests for GetSensorV2
+func NewGetSensorV2Request(server string, id SensorIDPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/sensors/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatsV2Request generates requests for GetStatsV2
+func NewGetStatsV2Request(server string, params *GetStatsV2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Layout != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "layout", runtime.ParamLocationQuery, *params.Layout); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HardwareType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hardwareType", runtime.ParamLocationQuery, *params.HardwareType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SensorType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sensorType", runtime.ParamLocationQuery, *params.SensorType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HardwareId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hardwareId", runtime.ParamLocationQuery, *params.HardwareId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SensorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sensorId", runtime.ParamLocationQuery, *params.SensorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Units != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "units", runtime.ParamLocationQuery, *params.Units); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// HealthCheckWithResponse request
+	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+
+	// GetStatsWithResponse request
+	GetStatsWithResponse(ctx context.Context, params *GetStatsParams, reqEditors ...RequestEditorFn) (*GetStatsResponse, error)
+
+	// GetStatsHistoryWithResponse request
+	GetStatsHistoryWithResponse(ctx context.Context, params *GetStatsHistoryParams, reqEditors ...RequestEditorFn) (*GetStatsHistoryResponse, error)
+
+	// StreamStatsWithResponse request
+	StreamStatsWithResponse(ctx context.Context, params *StreamStatsParams, reqEditors ...RequestEditorFn) (*StreamStatsResponse, error)
+
+	// GetStatusWithResponse request
+	GetStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusResponse, error)
+
+	// ListHardwareV2WithResponse request
+	ListHardwareV2WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListHardwareV2Response, error)
+
+	// GetHardwareV2WithResponse request
+	GetHardwareV2WithResponse(ctx context.Context, id HardwareIDPath, reqEditors ...RequestEditorFn) (*GetHardwareV2Response, error)
+
+	// ListHardwareSensorsV2WithResponse request
+	ListHardwareSensorsV2WithResponse(ctx context.Context, id HardwareIDPath, reqEditors ...RequestEditorFn) (*ListHardwareSensorsV2Response, error)
+
+	// GetSensorV2WithResponse request
+	GetSensorV2WithResponse(ctx context.Context, id SensorIDPath, reqEditors ...RequestEditorFn) (*GetSensorV2Response, error)
+
+	// GetStatsV2WithResponse request
+	GetStatsV2WithResponse(ctx context.Context, params *GetStatsV2Params, reqEditors ...RequestEditorFn) (*GetStatsV2Response, error)
+}
+
+type HealthCheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Stats
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatsHistory
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON501      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HostStatus
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListHardwareV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]HardwareV2
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListHardwareV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListHardwareV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHardwareV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HardwareV2
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHardwareV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHardwareV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListHardwareSensorsV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SensorV2
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListHardwareSensorsV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListHardwareSensorsV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSensorV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SensorV2
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSensorV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSensorV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StatsV2
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HealthCheckWithResponse request returning *HealthCheckResponse
+func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
+	rsp, err := c.HealthCheck(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthCheckResponse(rsp)
+}
+
+// GetStatsWithResponse request returning *GetStatsResponse
+func (c *ClientWithResponses) GetStatsWithResponse(ctx context.Context, params *GetStatsParams, reqEditors ...RequestEditorFn) (*GetStatsResponse, error) {
+	rsp, err := c.GetStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsResponse(rsp)
+}
+
+// GetStatsHistoryWithResponse request returning *GetStatsHistoryResponse
+func (c *ClientWithResponses) GetStatsHistoryWithResponse(ctx context.Context, params *GetStatsHistoryParams, reqEditors ...RequestEditorFn) (*GetStatsHistoryResponse, error) {
+	rsp, err := c.GetStatsHistory(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsHistoryResponse(rsp)
+}
+
+// StreamStatsWithResponse request returning *StreamStatsResponse
+func (c *ClientWithResponses) StreamStatsWithResponse(ctx context.Context, params *StreamStatsParams, reqEditors ...RequestEditorFn) (*StreamStatsResponse, error) {
+	rsp, err := c.StreamStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamStatsResponse(rsp)
+}
+
+// GetStatusWithResponse request returning *GetStatusResponse
+func (c *ClientWithResponses) GetStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusResponse, error) {
+	rsp, err := c.GetStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatusResponse(rsp)
+}
+
+// ListHardwareV2WithResponse request returning *ListHardwareV2Response
+func (c *ClientWithResponses) ListHardwareV2WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListHardwareV2Response, error) {
+	rsp, err := c.ListHardwareV2(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListHardwareV2Response(rsp)
+}
+
+// GetHardwareV2WithResponse request returning *GetHardwareV2Response
+func (c *ClientWithResponses) GetHardwareV2WithResponse(ctx context.Context, id HardwareIDPath, reqEditors ...RequestEditorFn) (*GetHardwareV2Response, error) {
+	rsp, err := c.GetHardwareV2(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHardwareV2Response(rsp)
+}
+
+// ListHardwareSensorsV2WithResponse request returning *ListHardwareSensorsV2Response
+func (c *ClientWithResponses) ListHardwareSensorsV2WithResponse(ctx context.Context, id HardwareIDPath, reqEditors ...RequestEditorFn) (*ListHardwareSensorsV2Response, error) {
+	rsp, err := c.ListHardwareSensorsV2(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListHardwareSensorsV2Response(rsp)
+}
+
+// GetSensorV2WithResponse request returning *GetSensorV2Response
+func (c *ClientWithResponses) GetSensorV2WithResponse(ctx context.Context, id SensorIDPath, reqEditors ...RequestEditorFn) (*GetSensorV2Response, error) {
+	rsp, err := c.GetSensorV2(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSensorV2Response(rsp)
+}
+
+// GetStatsV2WithResponse request returning *GetStatsV2Response
+func (c *ClientWithResponses) GetStatsV2WithResponse(ctx context.Context, params *GetStatsV2Params, reqEditors ...RequestEditorFn) (*GetStatsV2Response, error) {
+	rsp, err := c.GetStatsV2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsV2Response(rsp)
+}
+
+// ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
+func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthCheckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsResponse parses an HTTP response from a GetStatsWithResponse call
+func ParseGetStatsResponse(rsp *http.Response) (*GetStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Stats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsHistoryResponse parses an HTTP response from a GetStatsHistoryWithResponse call
+func ParseGetStatsHistoryResponse(rsp *http.Response) (*GetStatsHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatsHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamStatsResponse parses an HTTP response from a StreamStatsWithResponse call
+func ParseStreamStatsResponse(rsp *http.Response) (*StreamStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatusResponse parses an HTTP response from a GetStatusWithResponse call
+func ParseGetStatusResponse(rsp *http.Response) (*GetStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HostStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListHardwareV2Response parses an HTTP response from a ListHardwareV2WithResponse call
+func ParseListHardwareV2Response(rsp *http.Response) (*ListHardwareV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListHardwareV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []HardwareV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHardwareV2Response parses an HTTP response from a GetHardwareV2WithResponse call
+func ParseGetHardwareV2Response(rsp *http.Response) (*GetHardwareV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHardwareV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HardwareV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListHardwareSensorsV2Response parses an HTTP response from a ListHardwareSensorsV2WithResponse call
+func ParseListHardwareSensorsV2Response(rsp *http.Response) (*ListHardwareSensorsV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListHardwareSensorsV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SensorV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSensorV2Response parses an HTTP response from a GetSensorV2WithResponse call
+func ParseGetSensorV2Response(rsp *http.Response) (*GetSensorV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSensorV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SensorV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsV2Response parses an HTTP response from a GetStatsV2WithResponse call
+func ParseGetStatsV2Response(rsp *http.Response) (*GetStatsV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatsV2
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+//go:generate stringer -output=status_strings.go -type=Status -linecomment
+
+// Status is the health of a sensor judged by the thresholds, the greater status is the worse one.
+type Status int
+
+const (
+	StatusOK   Status = iota // OK
+	StatusWarn               // WARN
+	StatusCrit               // CRIT
+)
+
+// ParseStatus returns the status by its name, e.g. WARN, the name is case-insensitive.
+func ParseStatus(name string) (Status, error) {
+	for s := StatusOK; s <= StatusCrit; s++ {
+		if strings.EqualFold(s.String(), name) {
+			return s, nil
+		}
+	}
+	return StatusOK, fmt.Errorf("unknown status: %s", name)
+}
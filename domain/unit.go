@@ -0,0 +1,43 @@
+package domain
+
+// Unit returns the unit the backends report the values of the sensor type in, the units follow LibreHardwareMonitor.
+func (t SensorType) Unit() Unit {
+	switch t {
+	case Voltage:
+		return Volt
+	case Clock:
+		return Megahertz
+	case Temperature:
+		return Celsius
+	case Load, Control, Level, Humidity:
+		return Percentage
+	case Fan:
+		return RevolutionsPerMinute
+	case Flow:
+		return LitersPerHour
+	case Power:
+		return Watts
+	case SmallData:
+		return Megabytes
+	case Data:
+		return Gigabytes
+	case Throughput:
+		return BytesPerSecond
+	case Factor:
+		return Ratio
+	case Energy:
+		return MilliwattHours
+	case Current:
+		return Amperes
+	case Frequency:
+		return Hertz
+	case TimeSpan:
+		return Seconds
+	case Noise:
+		return Decibels
+	case Conductivity:
+		return MicrosiemensPerCentimeter
+	default:
+		return UnknownUnit
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os/signal"
@@ -39,6 +40,23 @@ func start(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return deps.HTTPServer().Run(ctx)
 	})
+	group.Go(func() error {
+		return deps.GRPCServer().Run(ctx)
+	})
+	group.Go(func() error {
+		return deps.AdminServer().Run(ctx)
+	})
+	group.Go(func() error {
+		return deps.StatsHub().Run(ctx)
+	})
+	group.Go(func() error {
+		return deps.HistoryRepo().Run(ctx)
+	})
+
+	err = group.Wait()
+	if shutdownErr := deps.Shutdown(); shutdownErr != nil {
+		err = errors.Join(err, fmt.Errorf("shutdown dependencies: %w", shutdownErr))
+	}
 
-	return group.Wait()
+	return err
 }
@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+type (
+	Config struct {
+		// Host is the address the server listens on, it is local by default since pprof discloses the internals.
+		Host string `envconfig:"APP_ADMIN_SERVER_HOST" default:"localhost"`
+		Port uint   `envconfig:"APP_ADMIN_SERVER_PORT" default:"6060"`
+	}
+
+	// Server serves the metrics of picker itself and pprof, it is kept apart from the API
+	// so the diagnostics are neither behind the API auth nor exposed along with the API.
+	Server struct {
+		cfg    Config
+		server *http.Server
+		logger logrus.FieldLogger
+	}
+)
+
+func NewServer(ctx context.Context, cfg Config, gatherer prometheus.Gatherer, logger logrus.FieldLogger) (*Server, error) {
+	if lo.IsNil(gatherer) {
+		return nil, fmt.Errorf("gatherer is nil")
+	}
+	if lo.IsNil(logger) {
+		return nil, fmt.Errorf("logger is nil")
+	}
+	addr := net.JoinHostPort(cfg.Host, strconv.FormatUint(uint64(cfg.Port), 10))
+	l := net.ListenConfig{}
+	ln, err := l.Listen(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("can't listen on %s: %w", addr, err)
+	}
+
+	if err = ln.Close(); err != nil {
+		return nil, fmt.Errorf("can't close listener: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	return &Server{
+		cfg: cfg,
+		server: &http.Server{
+			Addr:              addr,
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+		logger: logger,
+	}, nil
+}
+
+// Run starts the server and listens for incoming requests.
+// The server will be stopped when the context is canceled.
+func (s *Server) Run(ctx context.Context) error {
+	errChan := make(chan error, 1)
+	go func(ch chan error) {
+		s.logger.Debug("starting admin server")
+		ch <- s.server.ListenAndServe()
+	}(errChan)
+
+	select {
+	case <-ctx.Done():
+	case err := <-errChan:
+		return err
+	}
+
+	const shutdownTimeout = 5 * time.Second
+
+	timeout, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := s.server.Shutdown(timeout); err != nil { //nolint:contextcheck // false-positive: https://github.com/kkHAIKE/contextcheck/issues/2
+		return fmt.Errorf("shutdown admin server: %w", err)
+	}
+	s.logger.Debug("admin server stopped")
+
+	return nil
+}
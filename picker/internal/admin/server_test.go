@@ -0,0 +1,71 @@
+package admin_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/admin"
+	"github.com/genvmoroz/win-stats/picker/internal/testutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(prometheus.NewGauge(prometheus.GaugeOpts{Name: "picker_test_value", Help: "Test value"}))
+
+	cfg := admin.Config{Host: "localhost", Port: testutils.FreePort(t)}
+	server, err := admin.NewServer(context.Background(), cfg, registry, logrus.New())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- server.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	base := fmt.Sprintf("http://localhost:%d", cfg.Port)
+	require.Eventually(t, func() bool {
+		resp, err := http.Get(base + "/metrics") //nolint:noctx // the test server is local
+		if err != nil {
+			return false
+		}
+		_ = resp.Body.Close()
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	tests := map[string]struct {
+		path string
+		want string
+	}{
+		"metrics": {path: "/metrics", want: "picker_test_value 0"},
+		"pprof":   {path: "/debug/pprof/", want: "goroutine"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := http.Get(base + test.path) //nolint:noctx // the test server is local
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, resp.Body.Close())
+			}()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Contains(t, string(body), test.want)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+// Package auth checks the bearer tokens, it is shared by the HTTP and gRPC servers so they accept the same clients.
+package auth
+
+import "crypto/subtle"
+
+// ValidToken compares the token with every accepted one in constant time, so the timing doesn't reveal them.
+func ValidToken(token string, accepted []string) bool {
+	valid := false
+	for _, a := range accepted {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(a)) == 1 {
+			valid = true
+		}
+	}
+
+	return valid
+}
@@ -0,0 +1,32 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/genvmoroz/win-stats/picker/internal/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidToken(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		token    string
+		accepted []string
+		want     bool
+	}{
+		"accepted":      {token: "new", accepted: []string{"new"}, want: true},
+		"rotated":       {token: "old", accepted: []string{"old", "new"}, want: true},
+		"wrong":         {token: "wrong", accepted: []string{"old", "new"}},
+		"prefix":        {token: "ne", accepted: []string{"new"}},
+		"empty":         {token: "", accepted: []string{"new"}},
+		"none accepted": {token: "new"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.want, auth.ValidToken(test.token, test.accepted))
+		})
+	}
+}
@@ -3,8 +3,17 @@ package config
 import (
 	"fmt"
 
+	"github.com/genvmoroz/win-stats/picker/internal/admin"
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/internal/grpc"
 	"github.com/genvmoroz/win-stats/picker/internal/http"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/history"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/hwmon"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/prometheus"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/replay"
 	"github.com/genvmoroz/win-stats/picker/internal/repository/stats"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/synthetic"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/threshold"
 	"github.com/go-playground/validator/v10"
 	"github.com/kelseyhightower/envconfig"
 )
@@ -12,8 +21,19 @@ import (
 type Config struct {
 	LogLevel string `envconfig:"APP_LOG_LEVEL" default:"info"`
 
-	HTTPServer http.Config
-	CachedRepo stats.CachedRepoConfig
+	HTTPServer  http.Config
+	GRPCServer  grpc.Config
+	AdminServer admin.Config
+	Stats       stats.Config
+	CachedRepo  stats.CachedRepoConfig
+	Hwmon       hwmon.Config
+	Synthetic   synthetic.Config
+	Replay      replay.Config
+	Recorder    replay.RecorderConfig
+	Stream      core.StreamConfig
+	Metrics     prometheus.CollectorConfig
+	History     history.Config
+	Thresholds  threshold.Config
 }
 
 func FromEnv() (Config, error) {
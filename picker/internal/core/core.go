@@ -1,37 +1,139 @@
 package core
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/samber/lo"
 )
 
 type StatsRepo interface {
-	GetSensorsByHardware(ctx context.Context) (map[Hardware][]Sensor, error)
+	GetSensorsByHardware(ctx context.Context, filter StatsFilter) (map[Hardware][]Sensor, error)
+}
+
+// CachedStatsRepo is implemented by the repos serving the stats read earlier, the Service reports the age of the stats then.
+type CachedStatsRepo interface {
+	GetCachedSensorsByHardware(ctx context.Context, filter StatsFilter) (map[Hardware][]Sensor, Freshness, error)
 }
 
 type Service struct {
-	statsRepo StatsRepo
+	statsRepo   StatsRepo
+	hub         *StatsHub
+	historyRepo HistoryRepo
+	thresholds  Thresholds
 }
 
-func NewService(statsRepo StatsRepo) (*Service, error) {
+// NewService creates the Service, the sensors are OK if there are no thresholds.
+func NewService(statsRepo StatsRepo, hub *StatsHub, historyRepo HistoryRepo, thresholds Thresholds) (*Service, error) {
 	if lo.IsNil(statsRepo) {
 		return nil, errors.New("stats repo is nil")
 	}
+	if hub == nil {
+		return nil, errors.New("stats hub is nil")
+	}
+	if lo.IsNil(historyRepo) {
+		return nil, errors.New("history repo is nil")
+	}
 	return &Service{
-		statsRepo: statsRepo,
+		statsRepo:   statsRepo,
+		hub:         hub,
+		historyRepo: historyRepo,
+		thresholds:  thresholds,
 	}, nil
 }
 
-func (s *Service) GetStats(ctx context.Context) (GetStatsResponse, error) {
-	sensorsByHardware, err := s.statsRepo.GetSensorsByHardware(ctx)
+func (s *Service) GetStats(ctx context.Context, req GetStatsRequest) (GetStatsResponse, error) {
+	var (
+		sensorsByHardware map[Hardware][]Sensor
+		freshness         *Freshness
+		err               error
+	)
+	if cachedRepo, ok := s.statsRepo.(CachedStatsRepo); ok {
+		var f Freshness
+		sensorsByHardware, f, err = cachedRepo.GetCachedSensorsByHardware(ctx, req.Filter)
+		freshness = &f
+	} else {
+		sensorsByHardware, err = s.statsRepo.GetSensorsByHardware(ctx, req.Filter)
+	}
 	if err != nil {
 		return GetStatsResponse{}, fmt.Errorf("get sensors: %w", err)
 	}
 
 	return GetStatsResponse{
-		Stats: sensorsByHardware,
+		Stats:     s.annotateStats(req.Units, sensorsByHardware),
+		Warnings:  unknownTypeWarnings(sensorsByHardware),
+		Freshness: freshness,
 	}, nil
 }
+
+// unknownTypeWarnings describes the hardware and sensors of the types unknown to picker, sorted for a stable response.
+func unknownTypeWarnings(sensorsByHardware map[Hardware][]Sensor) []string {
+	var warnings []string
+	for hw, sensors := range sensorsByHardware {
+		if hw.Type == UnknownHardwareType {
+			warnings = append(warnings, fmt.Sprintf("hardware %s has unknown type %q", hw.ID, hw.RawType))
+		}
+		for _, sensor := range sensors {
+			if sensor.Type == UnknownSensorType {
+				warnings = append(warnings, fmt.Sprintf("sensor %s has unknown type %q", sensor.ID, sensor.RawType))
+			}
+		}
+	}
+	slices.Sort(warnings)
+
+	return warnings
+}
+
+// ErrNotFound is returned when the requested hardware or sensor is not present.
+var ErrNotFound = errors.New("not found")
+
+// GetHardware returns all the hardware sorted by ID.
+func (s *Service) GetHardware(ctx context.Context) ([]Hardware, error) {
+	sensorsByHardware, err := s.statsRepo.GetSensorsByHardware(ctx, StatsFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("get sensors: %w", err)
+	}
+
+	hardware := lo.Keys(sensorsByHardware)
+	slices.SortFunc(hardware, func(a, b Hardware) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return hardware, nil
+}
+
+func (s *Service) GetHardwareByID(ctx context.Context, id HardwareID) (Hardware, []Sensor, error) {
+	sensorsByHardware, err := s.statsRepo.GetSensorsByHardware(ctx, StatsFilter{HardwareIDs: []HardwareID{id}})
+	if err != nil {
+		return Hardware{}, nil, fmt.Errorf("get sensors: %w", err)
+	}
+
+	for hw, sensors := range sensorsByHardware {
+		if hw.ID == id {
+			return hw, s.annotateSensors(UnitPreferences{}, hw, sensors), nil
+		}
+	}
+
+	return Hardware{}, nil, fmt.Errorf("hardware %s: %w", id, ErrNotFound)
+}
+
+func (s *Service) GetSensor(ctx context.Context, id SensorID) (Sensor, error) {
+	sensorsByHardware, err := s.statsRepo.GetSensorsByHardware(ctx, StatsFilter{SensorIDs: []SensorID{id}})
+	if err != nil {
+		return Sensor{}, fmt.Errorf("get sensors: %w", err)
+	}
+
+	for hw, sensors := range sensorsByHardware {
+		for _, sensor := range sensors {
+			if sensor.ID == id {
+				sensor.Status, _ = s.thresholds.Evaluate(hw, sensor)
+				return UnitPreferences{}.Convert(sensor), nil
+			}
+		}
+	}
+
+	return Sensor{}, fmt.Errorf("sensor %s: %w", id, ErrNotFound)
+}
@@ -0,0 +1,150 @@
+package core_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceResources(t *testing.T) {
+	t.Parallel()
+
+	var (
+		cpu     = core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+		board   = core.Hardware{ID: "/motherboard", Type: core.Motherboard}
+		cpuTemp = core.Sensor{ID: "/intelcpu/0/temperature/0", HardwareID: cpu.ID, Type: core.Temperature, Unit: core.Celsius}
+		cpuLoad = core.Sensor{ID: "/intelcpu/0/load/0", HardwareID: cpu.ID, Type: core.Load, Unit: core.Percentage}
+	)
+
+	srv, _, err := newService(&fakeStatsRepo{
+		stats: map[core.Hardware][]core.Sensor{
+			cpu:   {cpuTemp, cpuLoad},
+			board: nil,
+		},
+	}, testStreamConfig)
+	require.NoError(t, err)
+
+	hardware, err := srv.GetHardware(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []core.Hardware{cpu, board}, hardware)
+
+	hw, sensors, err := srv.GetHardwareByID(context.Background(), cpu.ID)
+	require.NoError(t, err)
+	require.Equal(t, cpu, hw)
+	require.Equal(t, []core.Sensor{cpuTemp, cpuLoad}, sensors)
+
+	_, _, err = srv.GetHardwareByID(context.Background(), "/gpu-nvidia/0")
+	require.ErrorIs(t, err, core.ErrNotFound)
+
+	sensor, err := srv.GetSensor(context.Background(), cpuLoad.ID)
+	require.NoError(t, err)
+	require.Equal(t, cpuLoad, sensor)
+
+	_, err = srv.GetSensor(context.Background(), "/intelcpu/0/clock/0")
+	require.ErrorIs(t, err, core.ErrNotFound)
+}
+
+func TestServiceGetStatsWarnings(t *testing.T) {
+	t.Parallel()
+
+	var (
+		cpu     = core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+		qpu     = core.Hardware{ID: "/quantum/0", Type: core.UnknownHardwareType, RawType: "QPU"}
+		cpuTemp = core.Sensor{ID: "/intelcpu/0/temperature/0", HardwareID: cpu.ID, Type: core.Temperature}
+		qubits  = core.Sensor{ID: "/quantum/0/qubits/0", HardwareID: qpu.ID, Type: core.UnknownSensorType, RawType: "Qubits"}
+	)
+
+	srv, _, err := newService(&fakeStatsRepo{
+		stats: map[core.Hardware][]core.Sensor{
+			cpu: {cpuTemp},
+			qpu: {qubits},
+		},
+	}, testStreamConfig)
+	require.NoError(t, err)
+
+	resp, err := srv.GetStats(context.Background(), core.GetStatsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Stats, 2)
+	require.Equal(t,
+		[]string{
+			`hardware /quantum/0 has unknown type "QPU"`,
+			`sensor /quantum/0/qubits/0 has unknown type "Qubits"`,
+		},
+		resp.Warnings,
+	)
+}
+
+func TestServiceGetStatsFreshness(t *testing.T) {
+	t.Parallel()
+
+	cpu := core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+	stats := map[core.Hardware][]core.Sensor{cpu: {{ID: "/intelcpu/0/load/0", HardwareID: cpu.ID, Type: core.Load}}}
+
+	srv, _, err := newService(&fakeStatsRepo{stats: stats}, testStreamConfig)
+	require.NoError(t, err)
+
+	resp, err := srv.GetStats(context.Background(), core.GetStatsRequest{})
+	require.NoError(t, err)
+	require.Nil(t, resp.Freshness)
+
+	freshness := core.Freshness{Age: 3 * time.Second, Stale: true}
+	srv, _, err = newService(&fakeCachedStatsRepo{fakeStatsRepo: fakeStatsRepo{stats: stats}, freshness: freshness}, testStreamConfig)
+	require.NoError(t, err)
+
+	resp, err = srv.GetStats(context.Background(), core.GetStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &freshness, resp.Freshness)
+	require.Len(t, resp.Stats[cpu], 1)
+}
+
+var testStreamConfig = core.StreamConfig{SampleInterval: time.Second, History: 10}
+
+func newService(repo core.StatsRepo, cfg core.StreamConfig) (*core.Service, *core.StatsHub, error) {
+	hub, err := core.NewStatsHub(repo, cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	srv, err := core.NewService(repo, hub, &fakeHistoryRepo{}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return srv, hub, nil
+}
+
+// fakeStatsRepo applies the filter the way stats.Repo does.
+type fakeStatsRepo struct {
+	mux   sync.Mutex
+	stats map[core.Hardware][]core.Sensor
+}
+
+func (r *fakeStatsRepo) GetSensorsByHardware(_ context.Context, filter core.StatsFilter) (map[core.Hardware][]core.Sensor, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return filter.Apply(r.stats), nil
+}
+
+func (r *fakeStatsRepo) set(stats map[core.Hardware][]core.Sensor) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.stats = stats
+}
+
+// fakeCachedStatsRepo reports the same freshness for all the stats.
+type fakeCachedStatsRepo struct {
+	fakeStatsRepo
+	freshness core.Freshness
+}
+
+func (r *fakeCachedStatsRepo) GetCachedSensorsByHardware(
+	ctx context.Context,
+	filter core.StatsFilter,
+) (map[core.Hardware][]core.Sensor, core.Freshness, error) {
+	stats, err := r.GetSensorsByHardware(ctx, filter)
+	return stats, r.freshness, err
+}
@@ -1,58 +1,92 @@
 package core
 
-//go:generate stringer -output=enum_strings.go -type=HardwareType,SensorType,Unit
+import (
+	"github.com/genvmoroz/win-stats/domain"
+)
 
-type HardwareType int
+// The model is shared with the collectors by the domain module, the aliases keep it a part of the core API.
 
-const (
-	UnknownHardwareType HardwareType = iota
-	SuperIO
-	CPU
-	GPU
-	TBalancer
-	HeatMaster
-	HDD
-	RAM
-	Network
-	Memory
-	Storage
-	Motherboard
-	Battery
+type (
+	HardwareType = domain.HardwareType
+	SensorType   = domain.SensorType
+	Unit         = domain.Unit
 )
 
-type SensorType int
-
 const (
-	UnknownSensorType SensorType = iota
-	Voltage
-	Clock
-	Temperature
-	Load
-	Fan
-	Flow
-	Control
-	Level
-	Power
-	SmallData
-	Throughput
-	Data
-	Factor
-	Energy
-	Current
+	UnknownHardwareType = domain.UnknownHardwareType
+	SuperIO             = domain.SuperIO
+	CPU                 = domain.CPU
+	GPU                 = domain.GPU
+	TBalancer           = domain.TBalancer
+	HeatMaster          = domain.HeatMaster
+	HDD                 = domain.HDD
+	RAM                 = domain.RAM
+	Network             = domain.Network
+	Memory              = domain.Memory
+	Storage             = domain.Storage
+	Motherboard         = domain.Motherboard
+	Battery             = domain.Battery
+	Cooler              = domain.Cooler
+	EmbeddedController  = domain.EmbeddedController
+	PSU                 = domain.PSU
 )
 
-type Unit int
+const (
+	UnknownSensorType = domain.UnknownSensorType
+	Voltage           = domain.Voltage
+	Clock             = domain.Clock
+	Temperature       = domain.Temperature
+	Load              = domain.Load
+	Fan               = domain.Fan
+	Flow              = domain.Flow
+	Control           = domain.Control
+	Level             = domain.Level
+	Power             = domain.Power
+	SmallData         = domain.SmallData
+	Throughput        = domain.Throughput
+	Data              = domain.Data
+	Factor            = domain.Factor
+	Energy            = domain.Energy
+	Current           = domain.Current
+	Frequency         = domain.Frequency
+	TimeSpan          = domain.TimeSpan
+	Noise             = domain.Noise
+	Conductivity      = domain.Conductivity
+	Humidity          = domain.Humidity
+)
 
 const (
-	UnknownUnit Unit = iota
-	Volt
-	Megahertz
-	Celsius
-	Percentage
-	RevolutionsPerMinute
-	LitersPerHour
-	Watts
-	Gigabytes
-	Megabytes
-	KilobytesPerSecond
+	UnknownUnit               = domain.UnknownUnit
+	Volt                      = domain.Volt
+	Megahertz                 = domain.Megahertz
+	Celsius                   = domain.Celsius
+	Percentage                = domain.Percentage
+	RevolutionsPerMinute      = domain.RevolutionsPerMinute
+	LitersPerHour             = domain.LitersPerHour
+	Watts                     = domain.Watts
+	Gigabytes                 = domain.Gigabytes
+	Megabytes                 = domain.Megabytes
+	KilobytesPerSecond        = domain.KilobytesPerSecond
+	Amperes                   = domain.Amperes
+	MilliwattHours            = domain.MilliwattHours
+	BytesPerSecond            = domain.BytesPerSecond
+	MegabytesPerSecond        = domain.MegabytesPerSecond
+	Terabytes                 = domain.Terabytes
+	Fahrenheit                = domain.Fahrenheit
+	Gigahertz                 = domain.Gigahertz
+	Ratio                     = domain.Ratio
+	Hertz                     = domain.Hertz
+	Seconds                   = domain.Seconds
+	Decibels                  = domain.Decibels
+	MicrosiemensPerCentimeter = domain.MicrosiemensPerCentimeter
 )
+
+// ParseHardwareType returns the hardware type by its name, the name is case-insensitive.
+func ParseHardwareType(name string) (HardwareType, error) {
+	return domain.ParseHardwareType(name)
+}
+
+// ParseSensorType returns the sensor type by its name, the name is case-insensitive.
+func ParseSensorType(name string) (SensorType, error) {
+	return domain.ParseSensorType(name)
+}
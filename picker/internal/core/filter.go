@@ -0,0 +1,120 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// StatsFilter narrows the stats returned by the repo. The values of a single field are combined by OR,
+// the fields are combined by AND. The empty field does not narrow anything.
+type StatsFilter struct {
+	HardwareTypes []HardwareType
+	SensorTypes   []SensorType
+	HardwareIDs   []HardwareID
+	SensorIDs     []SensorID
+	// NamePatterns match the sensor names, case-insensitive. The pattern supports the wildcards
+	// '*' matching any sequence of characters and '?' matching a single character.
+	NamePatterns []string
+}
+
+func (f StatsFilter) IsEmpty() bool {
+	return len(f.HardwareTypes) == 0 && len(f.SensorTypes) == 0 && len(f.HardwareIDs) == 0 &&
+		len(f.SensorIDs) == 0 && len(f.NamePatterns) == 0
+}
+
+func (f StatsFilter) MatchHardware(hw Hardware) bool {
+	return matchAny(f.HardwareTypes, hw.Type) && matchAny(f.HardwareIDs, hw.ID)
+}
+
+// MatchSensor matches only the sensor's own fields, the hardware the sensor belongs to is matched by MatchHardware.
+func (f StatsFilter) MatchSensor(s Sensor) bool {
+	if !matchAny(f.SensorTypes, s.Type) || !matchAny(f.SensorIDs, s.ID) {
+		return false
+	}
+
+	return len(f.NamePatterns) == 0 || slices.ContainsFunc(f.NamePatterns, func(pattern string) bool {
+		return MatchNamePattern(pattern, s.Name)
+	})
+}
+
+// Apply returns the stats matching the filter the way the repo does: the matching hardware is kept
+// even if none of its sensors match, it may be the parent of the hardware with ones.
+func (f StatsFilter) Apply(stats map[Hardware][]Sensor) map[Hardware][]Sensor {
+	out := make(map[Hardware][]Sensor, len(stats))
+	for hw, sensors := range stats {
+		if !f.MatchHardware(hw) {
+			continue
+		}
+		out[hw] = nil
+		for _, sensor := range sensors {
+			if f.MatchSensor(sensor) {
+				out[hw] = append(out[hw], sensor)
+			}
+		}
+	}
+
+	return out
+}
+
+// Key returns a string identifying the filter, the equal filters have the same key regardless of the values order.
+func (f StatsFilter) Key() string {
+	return strings.Join(
+		[]string{
+			keyPart(f.HardwareTypes),
+			keyPart(f.SensorTypes),
+			keyPart(f.HardwareIDs),
+			keyPart(f.SensorIDs),
+			keyPart(f.NamePatterns),
+		},
+		"|",
+	)
+}
+
+// MatchNamePattern reports whether the name matches the pattern with the '*' and '?' wildcards, case-insensitive.
+func MatchNamePattern(pattern, name string) bool {
+	var (
+		p = []rune(strings.ToLower(pattern))
+		n = []rune(strings.ToLower(name))
+
+		pIdx, nIdx = 0, 0
+		// the position of the last '*' and the name position it was tried at, used to backtrack
+		starIdx, starMatch = -1, 0
+	)
+
+	for nIdx < len(n) {
+		switch {
+		case pIdx < len(p) && (p[pIdx] == '?' || p[pIdx] == n[nIdx]):
+			pIdx++
+			nIdx++
+		case pIdx < len(p) && p[pIdx] == '*':
+			starIdx, starMatch = pIdx, nIdx
+			pIdx++
+		case starIdx != -1:
+			starMatch++
+			pIdx, nIdx = starIdx+1, starMatch
+		default:
+			return false
+		}
+	}
+
+	for pIdx < len(p) && p[pIdx] == '*' {
+		pIdx++
+	}
+
+	return pIdx == len(p)
+}
+
+func matchAny[T comparable](values []T, value T) bool {
+	return len(values) == 0 || slices.Contains(values, value)
+}
+
+func keyPart[T any](values []T) string {
+	parts := make([]string, len(values))
+	for idx, v := range values {
+		parts[idx] = strconv.Quote(fmt.Sprint(v))
+	}
+	slices.Sort(parts)
+	return strings.Join(parts, ",")
+}
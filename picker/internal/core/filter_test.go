@@ -0,0 +1,82 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchNamePattern(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "", name: "", want: true},
+		{pattern: "", name: "CPU", want: false},
+		{pattern: "*", name: "", want: true},
+		{pattern: "*", name: "CPU Package", want: true},
+		{pattern: "cpu package", name: "CPU Package", want: true},
+		{pattern: "CPU Core #?", name: "CPU Core #1", want: true},
+		{pattern: "CPU Core #?", name: "CPU Core #12", want: false},
+		{pattern: "*Core*", name: "CPU Core #12", want: true},
+		{pattern: "*Core", name: "CPU Core #12", want: false},
+		{pattern: "GPU*Memory*Used", name: "GPU Memory Total Used", want: true},
+		{pattern: "*a*b", name: "aXbXb", want: true},
+		{pattern: "*a*b", name: "aXbXc", want: false},
+		{pattern: "Temp?", name: "Temp", want: false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, core.MatchNamePattern(test.pattern, test.name), "%q ~ %q", test.pattern, test.name)
+	}
+}
+
+func TestStatsFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	var (
+		cpu  = core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+		gpu  = core.Hardware{ID: "/gpu-nvidia/0", Type: core.GPU}
+		temp = core.Sensor{ID: "/intelcpu/0/temperature/0", Name: "CPU Package", Type: core.Temperature}
+		load = core.Sensor{ID: "/intelcpu/0/load/0", Name: "CPU Total", Type: core.Load}
+	)
+
+	empty := core.StatsFilter{}
+	require.True(t, empty.IsEmpty())
+	require.True(t, empty.MatchHardware(cpu))
+	require.True(t, empty.MatchSensor(temp))
+
+	filter := core.StatsFilter{
+		HardwareTypes: []core.HardwareType{core.CPU, core.Storage},
+		SensorTypes:   []core.SensorType{core.Temperature, core.Load},
+		NamePatterns:  []string{"*package", "GPU*"},
+	}
+	require.False(t, filter.IsEmpty())
+	require.True(t, filter.MatchHardware(cpu))
+	require.False(t, filter.MatchHardware(gpu))
+	require.True(t, filter.MatchSensor(temp))
+	require.False(t, filter.MatchSensor(load), "the name doesn't match")
+
+	byID := core.StatsFilter{HardwareIDs: []core.HardwareID{gpu.ID}, SensorIDs: []core.SensorID{load.ID}}
+	require.False(t, byID.MatchHardware(cpu))
+	require.True(t, byID.MatchHardware(gpu))
+	require.True(t, byID.MatchSensor(load))
+	require.False(t, byID.MatchSensor(temp))
+}
+
+func TestStatsFilterKey(t *testing.T) {
+	t.Parallel()
+
+	a := core.StatsFilter{SensorTypes: []core.SensorType{core.Fan, core.Temperature}, NamePatterns: []string{"a,b"}}
+	b := core.StatsFilter{SensorTypes: []core.SensorType{core.Temperature, core.Fan}, NamePatterns: []string{"a,b"}}
+	c := core.StatsFilter{SensorTypes: []core.SensorType{core.Temperature, core.Fan}, NamePatterns: []string{"a", "b"}}
+	d := core.StatsFilter{HardwareIDs: []core.HardwareID{"Fan"}, HardwareTypes: []core.HardwareType{core.CPU}}
+
+	require.Equal(t, a.Key(), b.Key())
+	require.NotEqual(t, a.Key(), c.Key())
+	require.NotEqual(t, a.Key(), d.Key())
+	require.NotEqual(t, core.StatsFilter{}.Key(), d.Key())
+}
@@ -0,0 +1,56 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrHistoryDisabled is returned when the history is requested but the background sampling is off.
+var ErrHistoryDisabled = errors.New("history is disabled")
+
+type HistoryRepo interface {
+	// GetSensorHistory returns the latest known state of the sensor and its values recorded within the window
+	// ending now, oldest first. The zero window returns all the recorded values.
+	GetSensorHistory(ctx context.Context, id SensorID, window time.Duration) (Sensor, []SensorValue, error)
+}
+
+type GetSensorHistoryRequest struct {
+	SensorIDs []SensorID
+	// Range is how far back the values are returned, all the recorded values are returned if it is zero.
+	Range time.Duration
+	Units UnitPreferences
+}
+
+type SensorHistory struct {
+	// Sensor is the latest known state of the sensor.
+	Sensor Sensor
+	Values []SensorValue
+}
+
+// GetSensorHistory returns the recorded values of the sensors in the order of the request.
+// It fails with ErrNotFound if any of the sensors has no history.
+func (s *Service) GetSensorHistory(ctx context.Context, req GetSensorHistoryRequest) ([]SensorHistory, error) {
+	out := make([]SensorHistory, 0, len(req.SensorIDs))
+	for _, id := range req.SensorIDs {
+		sensor, values, err := s.historyRepo.GetSensorHistory(ctx, id, req.Range)
+		if err != nil {
+			return nil, fmt.Errorf("get sensor history: %w", err)
+		}
+
+		history := SensorHistory{
+			Sensor: req.Units.Convert(sensor),
+			Values: make([]SensorValue, len(values)),
+		}
+		// the unit is picked by the latest value, so all the values of the series are in the same unit
+		for idx, value := range values {
+			sensor.Value = value
+			history.Values[idx] = convertTo(sensor, history.Sensor.Unit).Value
+		}
+
+		out = append(out, history)
+	}
+
+	return out, nil
+}
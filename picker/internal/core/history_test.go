@@ -0,0 +1,86 @@
+package core_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceGetSensorHistory(t *testing.T) {
+	t.Parallel()
+
+	var (
+		at      = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		cpuTemp = core.Sensor{ID: "/intelcpu/0/temperature/0", Type: core.Temperature, Value: core.SensorValue{Value: 30, Timestamp: at}}
+		ramUsed = core.Sensor{ID: "/ram/data/0", Type: core.Data, Value: core.SensorValue{Value: 2, Timestamp: at}}
+		history = &fakeHistoryRepo{
+			sensors: map[core.SensorID]core.Sensor{cpuTemp.ID: cpuTemp, ramUsed.ID: ramUsed},
+			values: map[core.SensorID][]core.SensorValue{
+				cpuTemp.ID: {
+					{Value: 20, Min: 10, Max: 40, Timestamp: at.Add(-time.Second)},
+					{Value: 30, Min: 10, Max: 40, Timestamp: at},
+				},
+				ramUsed.ID: {
+					{Value: 0.5, Min: 0.5, Max: 0.5, Timestamp: at.Add(-2 * time.Second)},
+					{Value: 1536, Min: 0.5, Max: 1536, Timestamp: at.Add(-time.Second)},
+					{Value: 2, Min: 0.5, Max: 1536, Timestamp: at},
+				},
+			},
+		}
+	)
+
+	hub, err := core.NewStatsHub(&fakeStatsRepo{}, testStreamConfig)
+	require.NoError(t, err)
+	srv, err := core.NewService(&fakeStatsRepo{}, hub, history, nil)
+	require.NoError(t, err)
+
+	got, err := srv.GetSensorHistory(context.Background(), core.GetSensorHistoryRequest{
+		SensorIDs: []core.SensorID{cpuTemp.ID},
+		Units:     core.UnitPreferences{Temperature: core.Fahrenheit},
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, core.Fahrenheit, got[0].Sensor.Unit)
+	require.InDelta(t, 86.0, got[0].Sensor.Value.Value, 1e-9)
+	require.Equal(t,
+		[]core.SensorValue{
+			{Value: 68, Min: 50, Max: 104, Timestamp: at.Add(-time.Second)},
+			{Value: 86, Min: 50, Max: 104, Timestamp: at},
+		},
+		got[0].Values,
+	)
+
+	// the latest value is in GB, so are the older ones, though they alone would be in MB and TB
+	got, err = srv.GetSensorHistory(context.Background(), core.GetSensorHistoryRequest{
+		SensorIDs: []core.SensorID{ramUsed.ID},
+		Units:     core.UnitPreferences{HumanReadableData: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, core.Gigabytes, got[0].Sensor.Unit)
+	require.Equal(t, history.values[ramUsed.ID], got[0].Values)
+
+	_, err = srv.GetSensorHistory(context.Background(), core.GetSensorHistoryRequest{
+		SensorIDs: []core.SensorID{cpuTemp.ID, "/intelcpu/0/load/0"},
+	})
+	require.ErrorIs(t, err, core.ErrNotFound)
+}
+
+// fakeHistoryRepo returns the recorded values of the sensors, the window is ignored.
+type fakeHistoryRepo struct {
+	sensors map[core.SensorID]core.Sensor
+	values  map[core.SensorID][]core.SensorValue
+}
+
+func (r *fakeHistoryRepo) GetSensorHistory(_ context.Context, id core.SensorID, _ time.Duration) (core.Sensor, []core.SensorValue, error) {
+	sensor, ok := r.sensors[id]
+	if !ok {
+		return core.Sensor{}, nil, fmt.Errorf("sensor %s: %w", id, core.ErrNotFound)
+	}
+
+	return sensor, r.values[id], nil
+}
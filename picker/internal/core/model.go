@@ -2,32 +2,36 @@ package core
 
 import (
 	"time"
-)
-
-type GetStatsResponse struct {
-	Stats map[Hardware][]Sensor
-}
 
-type (
-	HardwareID string
-	SensorID   string
+	"github.com/genvmoroz/win-stats/domain"
 )
 
-type Hardware struct {
-	ID   HardwareID
-	Name string
-	Type HardwareType
+type GetStatsRequest struct {
+	Filter StatsFilter
+	Units  UnitPreferences
 }
 
-type Sensor struct {
-	ID         SensorID
-	HardwareID HardwareID
-	Name       string
-	Type       SensorType
-	Value      SensorValue
+type GetStatsResponse struct {
+	Stats map[Hardware][]Sensor
+	// Warnings describe the issues that didn't fail the request, e.g. the hardware of an unknown type.
+	Warnings []string
+	// Freshness is nil if the repo doesn't tell the age of the stats.
+	Freshness *Freshness
 }
 
-type SensorValue struct {
-	Value     int64
-	Timestamp time.Time
+// Freshness tells how old the stats served by a cache are.
+type Freshness struct {
+	// Age is the time since the stats were read from the source.
+	Age time.Duration
+	// Stale is set if the stats are older than the cache retention: they are served while
+	// being refreshed or because the refresh failed.
+	Stale bool
 }
+
+type (
+	HardwareID  = domain.HardwareID
+	SensorID    = domain.SensorID
+	Hardware    = domain.Hardware
+	Sensor      = domain.Sensor
+	SensorValue = domain.SensorValue
+)
@@ -0,0 +1,148 @@
+package core
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/genvmoroz/win-stats/domain"
+)
+
+// Status is the health of a sensor judged by the thresholds, the greater status is the worse one.
+type Status = domain.Status
+
+const (
+	StatusOK   = domain.StatusOK
+	StatusWarn = domain.StatusWarn
+	StatusCrit = domain.StatusCrit
+)
+
+// ThresholdRule sets the bounds of the values of the sensors matching the filter. The bounds are compared
+// with the values in the default units, e.g. Celsius or RPM. A nil bound is not checked.
+type ThresholdRule struct {
+	Filter    StatsFilter
+	WarnAbove *float64
+	CritAbove *float64
+	WarnBelow *float64
+	CritBelow *float64
+}
+
+// Thresholds are the rules the sensor statuses are judged by, the last rule matching the sensor wins,
+// so the general rules go first and the specific ones override them.
+type Thresholds []ThresholdRule
+
+// Evaluate returns the status of the sensor and the reason of the WARN or CRIT one.
+// The sensor that no rule matches is OK. The sensor must have the value in the default units.
+func (t Thresholds) Evaluate(hw Hardware, sensor Sensor) (Status, string) {
+	for i := len(t) - 1; i >= 0; i-- {
+		rule := t[i]
+		if rule.Filter.MatchHardware(hw) && rule.Filter.MatchSensor(sensor) {
+			return rule.evaluate(sensor.Value.Value)
+		}
+	}
+
+	return StatusOK, ""
+}
+
+func (r ThresholdRule) evaluate(value float64) (Status, string) {
+	switch {
+	case r.CritAbove != nil && value > *r.CritAbove:
+		return StatusCrit, boundReason(value, "above the critical", *r.CritAbove)
+	case r.CritBelow != nil && value < *r.CritBelow:
+		return StatusCrit, boundReason(value, "below the critical", *r.CritBelow)
+	case r.WarnAbove != nil && value > *r.WarnAbove:
+		return StatusWarn, boundReason(value, "above the warning", *r.WarnAbove)
+	case r.WarnBelow != nil && value < *r.WarnBelow:
+		return StatusWarn, boundReason(value, "below the warning", *r.WarnBelow)
+	default:
+		return StatusOK, ""
+	}
+}
+
+// boundReason rounds the value to hundredths, the sensors report more digits than are meaningful.
+func boundReason(value float64, bound string, threshold float64) string {
+	return fmt.Sprintf("value %s is %s threshold %s",
+		strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64), bound, strconv.FormatFloat(threshold, 'f', -1, 64))
+}
+
+type HostStatus struct {
+	// Status is the worst status of the hardware.
+	Status   Status
+	Hardware []HardwareStatus
+}
+
+type HardwareStatus struct {
+	Hardware Hardware
+	// Status is the worst status of the hardware sensors.
+	Status Status
+	// Sensors are the sensors whose status is not OK.
+	Sensors []SensorStatus
+}
+
+type SensorStatus struct {
+	Sensor Sensor
+	Status Status
+	Reason string
+}
+
+// GetStatus rolls the sensor statuses up into the status of every hardware and of the host,
+// the hardware is sorted by ID.
+func (s *Service) GetStatus(ctx context.Context) (HostStatus, error) {
+	sensorsByHardware, err := s.statsRepo.GetSensorsByHardware(ctx, StatsFilter{})
+	if err != nil {
+		return HostStatus{}, fmt.Errorf("get sensors: %w", err)
+	}
+
+	out := HostStatus{Hardware: make([]HardwareStatus, 0, len(sensorsByHardware))}
+	for hw, sensors := range sensorsByHardware {
+		hwStatus := HardwareStatus{Hardware: hw}
+		for _, sensor := range sensors {
+			status, reason := s.thresholds.Evaluate(hw, sensor)
+			if status == StatusOK {
+				continue
+			}
+			sensor.Status = status
+			hwStatus.Sensors = append(hwStatus.Sensors, SensorStatus{
+				Sensor: UnitPreferences{}.Convert(sensor),
+				Status: status,
+				Reason: reason,
+			})
+			hwStatus.Status = max(hwStatus.Status, status)
+		}
+		out.Status = max(out.Status, hwStatus.Status)
+		out.Hardware = append(out.Hardware, hwStatus)
+	}
+	slices.SortFunc(out.Hardware, func(a, b HardwareStatus) int {
+		return cmp.Compare(a.Hardware.ID, b.Hardware.ID)
+	})
+
+	return out, nil
+}
+
+// annotateStats sets the statuses of the sensors and converts them to the preferred units,
+// the sensors are copied, the repo may return the cached ones.
+func (s *Service) annotateStats(prefs UnitPreferences, sensorsByHardware map[Hardware][]Sensor) map[Hardware][]Sensor {
+	out := make(map[Hardware][]Sensor, len(sensorsByHardware))
+	for hw, sensors := range sensorsByHardware {
+		out[hw] = s.annotateSensors(prefs, hw, sensors)
+	}
+
+	return out
+}
+
+func (s *Service) annotateSensors(prefs UnitPreferences, hw Hardware, sensors []Sensor) []Sensor {
+	if sensors == nil {
+		return nil
+	}
+
+	out := make([]Sensor, len(sensors))
+	for idx, sensor := range sensors {
+		sensor.Status, _ = s.thresholds.Evaluate(hw, sensor)
+		out[idx] = prefs.Convert(sensor)
+	}
+
+	return out
+}
@@ -0,0 +1,122 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	statusCPU   = core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+	statusBoard = core.Hardware{ID: "/motherboard", Type: core.Motherboard}
+	statusGPU   = core.Hardware{ID: "/gpu-nvidia/0", Type: core.GPU}
+
+	testThresholds = core.Thresholds{
+		{
+			Filter:    core.StatsFilter{SensorTypes: []core.SensorType{core.Temperature}},
+			WarnAbove: lo.ToPtr(70.0),
+			CritAbove: lo.ToPtr(80.0),
+		},
+		{
+			Filter:    core.StatsFilter{SensorTypes: []core.SensorType{core.Temperature}, NamePatterns: []string{"CPU Package"}},
+			WarnAbove: lo.ToPtr(85.0),
+			CritAbove: lo.ToPtr(95.0),
+		},
+		{
+			Filter:    core.StatsFilter{SensorTypes: []core.SensorType{core.Fan}},
+			CritBelow: lo.ToPtr(300.0),
+		},
+	}
+)
+
+func TestThresholdsEvaluate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		hw         core.Hardware
+		sensor     core.Sensor
+		wantStatus core.Status
+		wantReason string
+	}{
+		"no matching rule": {
+			hw:         statusCPU,
+			sensor:     core.Sensor{Type: core.Load, Value: core.SensorValue{Value: 100}},
+			wantStatus: core.StatusOK,
+		},
+		"general rule": {
+			hw:         statusGPU,
+			sensor:     core.Sensor{Name: "GPU Core", Type: core.Temperature, Value: core.SensorValue{Value: 75.004}},
+			wantStatus: core.StatusWarn,
+			wantReason: "value 75 is above the warning threshold 70",
+		},
+		"specific rule overrides general one": {
+			hw:         statusCPU,
+			sensor:     core.Sensor{Name: "CPU Package", Type: core.Temperature, Value: core.SensorValue{Value: 84.5}},
+			wantStatus: core.StatusOK,
+		},
+		"critical wins over warning": {
+			hw:         statusCPU,
+			sensor:     core.Sensor{Name: "CPU Package", Type: core.Temperature, Value: core.SensorValue{Value: 96}},
+			wantStatus: core.StatusCrit,
+			wantReason: "value 96 is above the critical threshold 95",
+		},
+		"below": {
+			hw:         statusBoard,
+			sensor:     core.Sensor{Name: "Fan #1", Type: core.Fan, Value: core.SensorValue{Value: 0}},
+			wantStatus: core.StatusCrit,
+			wantReason: "value 0 is below the critical threshold 300",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			status, reason := testThresholds.Evaluate(test.hw, test.sensor)
+			require.Equal(t, test.wantStatus, status)
+			require.Equal(t, test.wantReason, reason)
+		})
+	}
+}
+
+func TestServiceGetStatus(t *testing.T) {
+	t.Parallel()
+
+	var (
+		cpuTemp = core.Sensor{ID: "/intelcpu/0/temperature/0", Name: "CPU Package", Type: core.Temperature, Value: core.SensorValue{Value: 90}}
+		cpuLoad = core.Sensor{ID: "/intelcpu/0/load/0", Name: "CPU Total", Type: core.Load, Value: core.SensorValue{Value: 100}}
+		fan     = core.Sensor{ID: "/motherboard/fan/0", Name: "Fan #1", Type: core.Fan, Value: core.SensorValue{Value: 1200}}
+		repo    = &fakeStatsRepo{stats: map[core.Hardware][]core.Sensor{
+			statusCPU:   {cpuTemp, cpuLoad},
+			statusBoard: {fan},
+		}}
+	)
+
+	hub, err := core.NewStatsHub(repo, testStreamConfig)
+	require.NoError(t, err)
+	srv, err := core.NewService(repo, hub, &fakeHistoryRepo{}, testThresholds)
+	require.NoError(t, err)
+
+	got, err := srv.GetStatus(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, core.StatusWarn, got.Status)
+	require.Len(t, got.Hardware, 2)
+
+	require.Equal(t, statusCPU, got.Hardware[0].Hardware)
+	require.Equal(t, core.StatusWarn, got.Hardware[0].Status)
+	require.Len(t, got.Hardware[0].Sensors, 1)
+	require.Equal(t, cpuTemp.ID, got.Hardware[0].Sensors[0].Sensor.ID)
+	require.Equal(t, "value 90 is above the warning threshold 85", got.Hardware[0].Sensors[0].Reason)
+
+	require.Equal(t, statusBoard, got.Hardware[1].Hardware)
+	require.Equal(t, core.StatusOK, got.Hardware[1].Status)
+	require.Empty(t, got.Hardware[1].Sensors)
+
+	// the statuses are judged before the values are converted to the preferred units
+	stats, err := srv.GetStats(context.Background(), core.GetStatsRequest{Units: core.UnitPreferences{Temperature: core.Fahrenheit}})
+	require.NoError(t, err)
+	require.Equal(t, core.StatusWarn, stats.Stats[statusCPU][0].Status)
+	require.Equal(t, core.StatusOK, stats.Stats[statusCPU][1].Status)
+}
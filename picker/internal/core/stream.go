@@ -0,0 +1,295 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+type StreamConfig struct {
+	// SampleInterval is how often the stats are read for the stream subscribers, it is the shortest stream interval.
+	SampleInterval time.Duration `envconfig:"APP_STREAM_SAMPLE_INTERVAL" default:"1s" validate:"gt=0"`
+	// History is the number of the recent samples kept to resume the streams by the last event ID.
+	History int `envconfig:"APP_STREAM_HISTORY" default:"60" validate:"gt=0"`
+}
+
+// StatsHub reads the stats once per sample interval and shares them with all the stream subscribers,
+// so the number of the subscribers doesn't affect the load on the repo. It samples only while there are subscribers.
+type StatsHub struct {
+	statsRepo StatsRepo
+	cfg       StreamConfig
+	// epoch makes the sample IDs of different runs differ, so the ID of the previous run is not resumed
+	epoch int64
+
+	mux         *sync.Mutex
+	seq         uint64
+	subscribers map[chan sample]struct{}
+	history     []sample
+}
+
+// sample is the unfiltered stats read at a single tick.
+type sample struct {
+	id        string
+	timestamp time.Time
+	stats     map[Hardware][]Sensor
+	err       error
+}
+
+func NewStatsHub(statsRepo StatsRepo, cfg StreamConfig) (*StatsHub, error) {
+	if lo.IsNil(statsRepo) {
+		return nil, errors.New("stats repo is nil")
+	}
+	if cfg.SampleInterval <= 0 {
+		return nil, errors.New("sample interval must be positive")
+	}
+	if cfg.History <= 0 {
+		return nil, errors.New("history must be positive")
+	}
+
+	return &StatsHub{
+		statsRepo:   statsRepo,
+		cfg:         cfg,
+		epoch:       time.Now().UnixMilli(),
+		mux:         &sync.Mutex{},
+		subscribers: make(map[chan sample]struct{}),
+	}, nil
+}
+
+// Run samples the stats until the context is canceled.
+func (h *StatsHub) Run(ctx context.Context) error {
+	ticker := time.NewTicker(h.cfg.SampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			if !h.hasSubscribers() {
+				continue
+			}
+			h.publish(h.sample(ctx, now))
+		}
+	}
+}
+
+func (h *StatsHub) sample(ctx context.Context, now time.Time) sample {
+	stats, err := h.statsRepo.GetSensorsByHardware(ctx, StatsFilter{})
+	if err != nil {
+		err = fmt.Errorf("get sensors: %w", err)
+	}
+
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	h.seq++
+
+	return sample{
+		id:        fmt.Sprintf("%d-%d", h.epoch, h.seq),
+		timestamp: now,
+		stats:     stats,
+		err:       err,
+	}
+}
+
+// publish delivers the sample to every subscriber. A subscriber that hasn't taken the previous sample yet
+// gets only the latest one, so a slow client can't block the others.
+func (h *StatsHub) publish(s sample) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	if s.err == nil {
+		h.history = append(h.history, s)
+		if len(h.history) > h.cfg.History {
+			h.history = h.history[len(h.history)-h.cfg.History:]
+		}
+	}
+
+	for ch := range h.subscribers {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- s
+	}
+}
+
+func (h *StatsHub) subscribe() (<-chan sample, func()) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	ch := make(chan sample, 1)
+	h.subscribers[ch] = struct{}{}
+
+	return ch, func() {
+		h.mux.Lock()
+		defer h.mux.Unlock()
+
+		delete(h.subscribers, ch)
+	}
+}
+
+func (h *StatsHub) hasSubscribers() bool {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	return len(h.subscribers) > 0
+}
+
+// lookup returns the recent sample by its ID.
+func (h *StatsHub) lookup(id string) (sample, bool) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	return lo.Find(h.history, func(s sample) bool {
+		return s.id == id
+	})
+}
+
+type StatsEventKind string
+
+const (
+	// SnapshotEvent carries all the stats matching the request.
+	SnapshotEvent StatsEventKind = "snapshot"
+	// ChangesEvent carries only the sensors whose values changed since the previous event. The removed sensors
+	// can't be told by the changes, so the snapshot is sent instead once a sensor is gone.
+	ChangesEvent StatsEventKind = "changes"
+	// ErrorEvent reports a failed sample, the stream goes on.
+	ErrorEvent StatsEventKind = "error"
+)
+
+type StreamStatsRequest struct {
+	GetStatsRequest
+	// Interval is the minimal time between the events, the events are sent at the sample interval at most.
+	Interval time.Duration
+	// ChangesOnly makes the stream send the changed sensors after the first snapshot.
+	ChangesOnly bool
+	// LastEventID is the ID of the last event the client received before reconnecting.
+	LastEventID string
+}
+
+type StatsEvent struct {
+	ID       string
+	Kind     StatsEventKind
+	Stats    map[Hardware][]Sensor
+	Warnings []string
+	Err      error
+}
+
+// StreamStats sends the stats events until the context is canceled, the channel is closed then.
+// A client resuming the changes stream by the last event ID gets the changes since that event
+// if it is still in the history, otherwise it starts with a snapshot.
+func (s *Service) StreamStats(ctx context.Context, req StreamStatsRequest) (<-chan StatsEvent, error) {
+	if req.Interval <= 0 {
+		return nil, errors.New("interval must be positive")
+	}
+
+	samples, unsubscribe := s.hub.subscribe()
+
+	var known map[SensorID]Sensor
+	if req.ChangesOnly && req.LastEventID != "" {
+		if prev, ok := s.hub.lookup(req.LastEventID); ok {
+			known = indexSensors(s.statsView(prev.stats, req.GetStatsRequest))
+		}
+	}
+
+	// the samples are taken at the ticks of the sample interval, the tolerance keeps a jitter from skipping a tick
+	tolerance := s.hub.cfg.SampleInterval / 2
+
+	events := make(chan StatsEvent)
+	go func() {
+		defer close(events)
+		defer unsubscribe()
+
+		var sentAt time.Time
+		for {
+			var smp sample
+			select {
+			case <-ctx.Done():
+				return
+			case smp = <-samples:
+			}
+
+			if !sentAt.IsZero() && smp.timestamp.Sub(sentAt) < req.Interval-tolerance {
+				continue
+			}
+			sentAt = smp.timestamp
+
+			event := StatsEvent{ID: smp.id, Kind: SnapshotEvent, Err: smp.err}
+			if smp.err != nil {
+				event.Kind = ErrorEvent
+			} else {
+				stats := s.statsView(smp.stats, req.GetStatsRequest)
+				event.Stats = stats
+				event.Warnings = unknownTypeWarnings(stats)
+				if req.ChangesOnly {
+					current := indexSensors(stats)
+					if known != nil && !sensorsRemoved(known, current) {
+						event.Kind = ChangesEvent
+						event.Stats = changedSensors(known, stats)
+					}
+					known = current
+					if event.Kind == ChangesEvent && len(event.Stats) == 0 {
+						continue
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case events <- event:
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// statsView applies the filter, the thresholds and the unit preferences of the request to the unfiltered stats.
+func (s *Service) statsView(stats map[Hardware][]Sensor, req GetStatsRequest) map[Hardware][]Sensor {
+	return s.annotateStats(req.Units, req.Filter.Apply(stats))
+}
+
+func indexSensors(stats map[Hardware][]Sensor) map[SensorID]Sensor {
+	out := make(map[SensorID]Sensor)
+	for _, sensors := range stats {
+		for _, sensor := range sensors {
+			out[sensor.ID] = sensor
+		}
+	}
+
+	return out
+}
+
+// sensorsRemoved reports whether any of the known sensors is not among the current ones.
+func sensorsRemoved(known, current map[SensorID]Sensor) bool {
+	for id := range known {
+		if _, ok := current[id]; !ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// changedSensors returns the sensors that are new or whose values differ from the known ones, grouped by hardware.
+// The timestamp is not compared, it changes with every sample.
+func changedSensors(known map[SensorID]Sensor, stats map[Hardware][]Sensor) map[Hardware][]Sensor {
+	out := make(map[Hardware][]Sensor)
+	for hw, sensors := range stats {
+		for _, sensor := range sensors {
+			prev, ok := known[sensor.ID]
+			if ok && prev.Unit == sensor.Unit && prev.Value.Value == sensor.Value.Value &&
+				prev.Value.Min == sensor.Value.Min && prev.Value.Max == sensor.Value.Max {
+				continue
+			}
+			out[hw] = append(out[hw], sensor)
+		}
+	}
+
+	return out
+}
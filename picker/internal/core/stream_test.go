@@ -0,0 +1,176 @@
+package core_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceStreamStats(t *testing.T) {
+	t.Parallel()
+
+	var (
+		cpu  = core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+		temp = func(value float64) core.Sensor {
+			return core.Sensor{ID: "/intelcpu/0/temperature/0", HardwareID: cpu.ID, Type: core.Temperature, Value: core.SensorValue{Value: value}}
+		}
+		load = func(value float64) core.Sensor {
+			return core.Sensor{ID: "/intelcpu/0/load/0", HardwareID: cpu.ID, Type: core.Load, Value: core.SensorValue{Value: value}}
+		}
+		repo = &fakeStatsRepo{stats: map[core.Hardware][]core.Sensor{cpu: {temp(20), load(5)}}}
+	)
+
+	srv, hub, err := newService(repo, core.StreamConfig{SampleInterval: 5 * time.Millisecond, History: 1000})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		_ = hub.Run(ctx)
+	}()
+
+	req := core.StreamStatsRequest{
+		GetStatsRequest: core.GetStatsRequest{
+			Filter: core.StatsFilter{SensorTypes: []core.SensorType{core.Temperature}},
+			Units:  core.UnitPreferences{Temperature: core.Fahrenheit},
+		},
+		Interval:    time.Millisecond,
+		ChangesOnly: true,
+	}
+
+	streamCtx, stopStream := context.WithCancel(ctx)
+	events, err := srv.StreamStats(streamCtx, req)
+	require.NoError(t, err)
+
+	first := nextEvent(t, events)
+	require.Equal(t, core.SnapshotEvent, first.Kind)
+	require.Len(t, first.Stats[cpu], 1)
+	require.Equal(t, core.Fahrenheit, first.Stats[cpu][0].Unit)
+	require.InDelta(t, 68.0, first.Stats[cpu][0].Value.Value, 1e-9)
+
+	// the load is filtered out, its change doesn't produce an event
+	repo.set(map[core.Hardware][]core.Sensor{cpu: {temp(20), load(50)}})
+	noEvent(t, events, 10*5*time.Millisecond)
+
+	repo.set(map[core.Hardware][]core.Sensor{cpu: {temp(30), load(50)}})
+
+	changes := nextEvent(t, events)
+	require.Equal(t, core.ChangesEvent, changes.Kind)
+	require.Len(t, changes.Stats[cpu], 1)
+	require.InDelta(t, 86.0, changes.Stats[cpu][0].Value.Value, 1e-9)
+
+	// the stream is closed once it's stopped, so the hub stops sampling and keeps the history for the resume
+	stopStream()
+	require.Eventually(t, func() bool {
+		_, ok := <-events
+		return !ok
+	}, time.Second, time.Millisecond)
+
+	t.Run("resume by last event id", func(t *testing.T) {
+		resumed := req
+		resumed.LastEventID = first.ID
+
+		events, err := srv.StreamStats(ctx, resumed)
+		require.NoError(t, err)
+
+		event := nextEvent(t, events)
+		require.Equal(t, core.ChangesEvent, event.Kind)
+		require.InDelta(t, 86.0, event.Stats[cpu][0].Value.Value, 1e-9)
+	})
+
+	t.Run("unknown last event id", func(t *testing.T) {
+		resumed := req
+		resumed.LastEventID = "1-1"
+
+		events, err := srv.StreamStats(ctx, resumed)
+		require.NoError(t, err)
+
+		event := nextEvent(t, events)
+		require.Equal(t, core.SnapshotEvent, event.Kind)
+		require.InDelta(t, 86.0, event.Stats[cpu][0].Value.Value, 1e-9)
+	})
+}
+
+func TestServiceStreamStatsRemovedSensor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		cpu  = core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+		temp = func(index int, value float64) core.Sensor {
+			return core.Sensor{
+				ID: core.SensorID(fmt.Sprintf("/intelcpu/0/temperature/%d", index)), HardwareID: cpu.ID,
+				Type: core.Temperature, Index: index, Value: core.SensorValue{Value: value},
+			}
+		}
+		repo = &fakeStatsRepo{stats: map[core.Hardware][]core.Sensor{cpu: {temp(0, 20), temp(1, 25)}}}
+	)
+
+	srv, hub, err := newService(repo, core.StreamConfig{SampleInterval: 5 * time.Millisecond, History: 1000})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		_ = hub.Run(ctx)
+	}()
+
+	events, err := srv.StreamStats(ctx, core.StreamStatsRequest{Interval: time.Millisecond, ChangesOnly: true})
+	require.NoError(t, err)
+
+	first := nextEvent(t, events)
+	require.Equal(t, core.SnapshotEvent, first.Kind)
+	require.Len(t, first.Stats[cpu], 2)
+
+	// the changes can't tell the removed sensor, so the snapshot replaces the sensors the client keeps
+	repo.set(map[core.Hardware][]core.Sensor{cpu: {temp(0, 20)}})
+
+	removed := nextEvent(t, events)
+	require.Equal(t, core.SnapshotEvent, removed.Kind)
+	require.Len(t, removed.Stats[cpu], 1)
+	require.Equal(t, temp(0, 20).ID, removed.Stats[cpu][0].ID)
+
+	repo.set(map[core.Hardware][]core.Sensor{cpu: {temp(0, 30)}})
+
+	changes := nextEvent(t, events)
+	require.Equal(t, core.ChangesEvent, changes.Kind)
+	require.Len(t, changes.Stats[cpu], 1)
+	require.InDelta(t, 30.0, changes.Stats[cpu][0].Value.Value, 1e-9)
+}
+
+func TestServiceStreamStatsInvalidInterval(t *testing.T) {
+	t.Parallel()
+
+	srv, _, err := newService(&fakeStatsRepo{}, testStreamConfig)
+	require.NoError(t, err)
+
+	_, err = srv.StreamStats(context.Background(), core.StreamStatsRequest{})
+	require.Error(t, err)
+}
+
+func nextEvent(t *testing.T, events <-chan core.StatsEvent) core.StatsEvent {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "stream is closed")
+		return event
+	case <-time.After(time.Second):
+		require.FailNow(t, "no event in time")
+		return core.StatsEvent{}
+	}
+}
+
+// noEvent waits for the given time, long enough for several samples, and fails if an event is received.
+func noEvent(t *testing.T, events <-chan core.StatsEvent, wait time.Duration) {
+	t.Helper()
+
+	select {
+	case event := <-events:
+		require.FailNow(t, "unexpected event", "%+v", event)
+	case <-time.After(wait):
+	}
+}
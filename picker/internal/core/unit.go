@@ -0,0 +1,124 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// UnitPreferences are the units the sensor values are converted to. The zero value keeps the units
+// the backends report the values in.
+type UnitPreferences struct {
+	// Temperature is either Celsius or Fahrenheit.
+	Temperature Unit
+	// Clock is either Megahertz or Gigahertz.
+	Clock Unit
+	// Throughput is either BytesPerSecond, KilobytesPerSecond or MegabytesPerSecond.
+	Throughput Unit
+	// HumanReadableData picks the largest of Megabytes, Gigabytes and Terabytes the data size is at least 1 in.
+	HumanReadableData bool
+}
+
+// unitPreferenceNames are the names of the preferences accepted by ParseUnitPreferences.
+var unitPreferenceNames = map[string]func(*UnitPreferences){
+	"celsius":    func(p *UnitPreferences) { p.Temperature = Celsius },
+	"fahrenheit": func(p *UnitPreferences) { p.Temperature = Fahrenheit },
+	"mhz":        func(p *UnitPreferences) { p.Clock = Megahertz },
+	"ghz":        func(p *UnitPreferences) { p.Clock = Gigahertz },
+	"bytes/s":    func(p *UnitPreferences) { p.Throughput = BytesPerSecond },
+	"kb/s":       func(p *UnitPreferences) { p.Throughput = KilobytesPerSecond },
+	"mb/s":       func(p *UnitPreferences) { p.Throughput = MegabytesPerSecond },
+	"human":      func(p *UnitPreferences) { p.HumanReadableData = true },
+}
+
+// ParseUnitPreferences returns the preferences by their names, e.g. "fahrenheit" or "GHz", the names are case-insensitive.
+// The later name wins if several ones set the same unit.
+func ParseUnitPreferences(names []string) (UnitPreferences, error) {
+	var prefs UnitPreferences
+	for _, name := range names {
+		set, ok := unitPreferenceNames[strings.ToLower(name)]
+		if !ok {
+			return UnitPreferences{}, fmt.Errorf("unknown unit preference: %s", name)
+		}
+		set(&prefs)
+	}
+
+	return prefs, nil
+}
+
+// Convert sets the unit of the sensor and converts its values to the preferred unit, if there is one.
+func (p UnitPreferences) Convert(s Sensor) Sensor {
+	return convertTo(s, p.target(s.Type, s.Value.Value))
+}
+
+// convertTo sets the unit of the sensor and converts its values to the given unit, UnknownUnit keeps
+// the unit the backends report the values in.
+func convertTo(s Sensor, to Unit) Sensor {
+	from := s.Type.Unit()
+
+	s.Unit = from
+	if to == UnknownUnit || to == from {
+		return s
+	}
+
+	convert, ok := conversions[[2]Unit{from, to}]
+	if !ok {
+		return s
+	}
+
+	s.Unit = to
+	s.Value.Value = convert(s.Value.Value)
+	s.Value.Min = convert(s.Value.Min)
+	s.Value.Max = convert(s.Value.Max)
+
+	return s
+}
+
+func (p UnitPreferences) target(t SensorType, value float64) Unit {
+	switch t {
+	case Temperature:
+		return p.Temperature
+	case Clock:
+		return p.Clock
+	case Throughput:
+		return p.Throughput
+	case Data, SmallData:
+		if !p.HumanReadableData {
+			return UnknownUnit
+		}
+		return dataSizeUnit(t.Unit(), value)
+	default:
+		return UnknownUnit
+	}
+}
+
+// dataSizeUnit returns the largest data size unit the value is at least 1 in, the value is in the given unit.
+func dataSizeUnit(from Unit, value float64) Unit {
+	megabytes := value
+	if from == Gigabytes {
+		megabytes = value * binaryFactor
+	}
+
+	switch abs := math.Abs(megabytes); {
+	case abs >= binaryFactor*binaryFactor:
+		return Terabytes
+	case abs >= binaryFactor:
+		return Gigabytes
+	default:
+		return Megabytes
+	}
+}
+
+// binaryFactor is the ratio of the adjacent data size units, LibreHardwareMonitor uses the binary ones.
+const binaryFactor = 1024
+
+var conversions = map[[2]Unit]func(float64) float64{
+	{Celsius, Fahrenheit}:                func(v float64) float64 { return v*9/5 + 32 },
+	{Megahertz, Gigahertz}:               func(v float64) float64 { return v / 1000 },
+	{BytesPerSecond, KilobytesPerSecond}: func(v float64) float64 { return v / binaryFactor },
+	{BytesPerSecond, MegabytesPerSecond}: func(v float64) float64 { return v / binaryFactor / binaryFactor },
+	{Megabytes, Gigabytes}:               func(v float64) float64 { return v / binaryFactor },
+	{Megabytes, Terabytes}:               func(v float64) float64 { return v / binaryFactor / binaryFactor },
+	{Gigabytes, Megabytes}:               func(v float64) float64 { return v * binaryFactor },
+	{Gigabytes, Terabytes}:               func(v float64) float64 { return v / binaryFactor },
+}
@@ -0,0 +1,104 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitPreferencesConvert(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		units     []string
+		sensor    core.Sensor
+		wantUnit  core.Unit
+		wantValue core.SensorValue
+	}{
+		"default units": {
+			sensor:    sensorOf(core.Temperature, 40, 30, 90),
+			wantUnit:  core.Celsius,
+			wantValue: core.SensorValue{Value: 40, Min: 30, Max: 90},
+		},
+		"fahrenheit": {
+			units:     []string{"Fahrenheit"},
+			sensor:    sensorOf(core.Temperature, 40, -40, 100),
+			wantUnit:  core.Fahrenheit,
+			wantValue: core.SensorValue{Value: 104, Min: -40, Max: 212},
+		},
+		"gigahertz": {
+			units:     []string{"GHz"},
+			sensor:    sensorOf(core.Clock, 4500, 800, 5200),
+			wantUnit:  core.Gigahertz,
+			wantValue: core.SensorValue{Value: 4.5, Min: 0.8, Max: 5.2},
+		},
+		"preference of another type": {
+			units:     []string{"ghz"},
+			sensor:    sensorOf(core.Temperature, 40, 30, 90),
+			wantUnit:  core.Celsius,
+			wantValue: core.SensorValue{Value: 40, Min: 30, Max: 90},
+		},
+		"kilobytes per second": {
+			units:     []string{"kb/s"},
+			sensor:    sensorOf(core.Throughput, 2048, 0, 10240),
+			wantUnit:  core.KilobytesPerSecond,
+			wantValue: core.SensorValue{Value: 2, Min: 0, Max: 10},
+		},
+		"later preference wins": {
+			units:     []string{"kb/s", "mb/s"},
+			sensor:    sensorOf(core.Throughput, 1048576, 0, 2097152),
+			wantUnit:  core.MegabytesPerSecond,
+			wantValue: core.SensorValue{Value: 1, Min: 0, Max: 2},
+		},
+		"human small data": {
+			units:     []string{"human"},
+			sensor:    sensorOf(core.SmallData, 3072, 1024, 4096),
+			wantUnit:  core.Gigabytes,
+			wantValue: core.SensorValue{Value: 3, Min: 1, Max: 4},
+		},
+		"human data below gigabyte": {
+			units:     []string{"human"},
+			sensor:    sensorOf(core.Data, 0.5, 0, 1),
+			wantUnit:  core.Megabytes,
+			wantValue: core.SensorValue{Value: 512, Min: 0, Max: 1024},
+		},
+		"human data above terabyte": {
+			units:     []string{"human"},
+			sensor:    sensorOf(core.Data, 2048, 1024, 3072),
+			wantUnit:  core.Terabytes,
+			wantValue: core.SensorValue{Value: 2, Min: 1, Max: 3},
+		},
+		"unknown type": {
+			units:     []string{"fahrenheit", "human"},
+			sensor:    sensorOf(core.UnknownSensorType, 1, 0, 2),
+			wantUnit:  core.UnknownUnit,
+			wantValue: core.SensorValue{Value: 1, Min: 0, Max: 2},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prefs, err := core.ParseUnitPreferences(test.units)
+			require.NoError(t, err)
+
+			got := prefs.Convert(test.sensor)
+			require.Equal(t, test.wantUnit, got.Unit)
+			require.InDelta(t, test.wantValue.Value, got.Value.Value, 1e-9)
+			require.InDelta(t, test.wantValue.Min, got.Value.Min, 1e-9)
+			require.InDelta(t, test.wantValue.Max, got.Value.Max, 1e-9)
+		})
+	}
+}
+
+func TestParseUnitPreferencesUnknown(t *testing.T) {
+	t.Parallel()
+
+	_, err := core.ParseUnitPreferences([]string{"fahrenheit", "kelvin"})
+	require.EqualError(t, err, "unknown unit preference: kelvin")
+}
+
+func sensorOf(t core.SensorType, value, low, high float64) core.Sensor {
+	return core.Sensor{Type: t, Value: core.SensorValue{Value: value, Min: low, Max: high}}
+}
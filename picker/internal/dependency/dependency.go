@@ -4,17 +4,30 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/genvmoroz/win-stats/picker/internal/admin"
 	"github.com/genvmoroz/win-stats/picker/internal/config"
 	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/internal/grpc"
 	"github.com/genvmoroz/win-stats/picker/internal/http"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/history"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/prometheus"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/replay"
 	"github.com/genvmoroz/win-stats/picker/internal/repository/stats"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/synthetic"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/threshold"
 	"github.com/genvmoroz/win-stats/picker/internal/repository/timegen"
+	prometheusclient "github.com/prometheus/client_golang/prometheus"
 	"github.com/samber/do"
 	"github.com/sirupsen/logrus"
 )
 
 type Dependency struct {
-	httpServer *http.Server
+	injector    *do.Injector
+	httpServer  *http.Server
+	grpcServer  *grpc.Server
+	adminServer *admin.Server
+	statsHub    *core.StatsHub
+	historyRepo *history.Repo
 }
 
 func MustBuild(ctx context.Context) Dependency {
@@ -24,41 +37,172 @@ func MustBuild(ctx context.Context) Dependency {
 
 	do.Provide(injector, NewConfig)
 	do.Provide(injector, NewLogger)
+	do.Provide(injector, NewSourceRegistry)
+	do.Provide(injector, NewStatsSource)
+	do.Provide(injector, NewUnknownItemsReporter)
+	do.Provide(injector, NewSelfReporter)
 	do.Provide(injector, NewStatsRepo)
+	do.Provide(injector, NewRecordedStatsRepo)
 	do.Provide(injector, NewSingleflightStatsRepo)
 	do.Provide(injector, NewCachedStatsRepo)
+	do.Provide(injector, NewStatsHub)
+	do.Provide(injector, NewHistoryRepo)
+	do.Provide(injector, NewThresholds)
+	do.Provide(injector, NewSensorsRegistry)
+	do.Provide(injector, NewStatsCollector)
 	do.Provide(injector, NewCoreService)
 	do.Provide(injector, NewRouter)
 	do.Provide(injector, NewHTTPServer(ctx))
+	do.Provide(injector, NewGRPCHandler)
+	do.Provide(injector, NewGRPCServer(ctx))
+	do.Provide(injector, NewAdminServer(ctx))
+
+	// the collector is not used directly, it is registered to serve the stats on /metrics
+	do.MustInvoke[*prometheus.StatsCollector](injector)
 
 	return Dependency{
-		httpServer: do.MustInvoke[*http.Server](injector),
+		injector:    injector,
+		httpServer:  do.MustInvoke[*http.Server](injector),
+		grpcServer:  do.MustInvoke[*grpc.Server](injector),
+		adminServer: do.MustInvoke[*admin.Server](injector),
+		statsHub:    do.MustInvoke[*core.StatsHub](injector),
+		historyRepo: do.MustInvoke[*history.Repo](injector),
 	}
 }
 
+// Shutdown releases the dependencies holding the resources, e.g. the recording file.
+func (d *Dependency) Shutdown() error {
+	return d.injector.Shutdown()
+}
+
 func (d *Dependency) HTTPServer() *http.Server {
 	return d.httpServer
 }
 
+func (d *Dependency) GRPCServer() *grpc.Server {
+	return d.grpcServer
+}
+
+func (d *Dependency) AdminServer() *admin.Server {
+	return d.adminServer
+}
+
+func (d *Dependency) StatsHub() *core.StatsHub {
+	return d.statsHub
+}
+
+func (d *Dependency) HistoryRepo() *history.Repo {
+	return d.historyRepo
+}
+
+func NewSourceRegistry(injector *do.Injector) (*stats.Registry, error) {
+	registry := stats.NewRegistry()
+
+	if err := registerPlatformSources(injector, registry); err != nil {
+		return nil, fmt.Errorf("register platform sources: %w", err)
+	}
+
+	err := registry.Register(stats.SyntheticBackend, func() (stats.Source, error) {
+		var (
+			cfg           = do.MustInvoke[config.Config](injector)
+			timeGenerator = do.MustInvoke[*timegen.TimeGenerator](injector)
+		)
+
+		return synthetic.NewRepo(cfg.Synthetic, timeGenerator)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("register synthetic source: %w", err)
+	}
+
+	err = registry.Register(stats.ReplayBackend, func() (stats.Source, error) {
+		var (
+			cfg           = do.MustInvoke[config.Config](injector)
+			timeGenerator = do.MustInvoke[*timegen.TimeGenerator](injector)
+		)
+
+		return replay.NewRepo(cfg.Replay, timeGenerator)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("register replay source: %w", err)
+	}
+
+	return registry, nil
+}
+
+func NewStatsSource(injector *do.Injector) (stats.Source, error) {
+	var (
+		cfg      = do.MustInvoke[config.Config](injector)
+		registry = do.MustInvoke[*stats.Registry](injector)
+	)
+
+	return registry.NewSource(cfg.Stats.Backend)
+}
+
+func NewUnknownItemsReporter(_ *do.Injector) (*prometheus.UnknownItemsReporter, error) {
+	reporter := prometheus.NewUnknownItemsReporter()
+	if err := reporter.Register(); err != nil {
+		return nil, fmt.Errorf("register unknown items reporter: %w", err)
+	}
+
+	return reporter, nil
+}
+
+// NewSelfReporter reports how picker performs, the queries are labeled with the configured backend.
+func NewSelfReporter(injector *do.Injector) (*prometheus.SelfReporter, error) {
+	cfg := do.MustInvoke[config.Config](injector)
+
+	reporter := prometheus.NewSelfReporter(string(cfg.Stats.Backend))
+	if err := reporter.Register(prometheusclient.DefaultRegisterer); err != nil {
+		return nil, fmt.Errorf("register self reporter: %w", err)
+	}
+
+	return reporter, nil
+}
+
 func NewStatsRepo(injector *do.Injector) (*stats.Repo, error) {
-	timeGenerator := do.MustInvoke[*timegen.TimeGenerator](injector)
+	var (
+		source       = do.MustInvoke[stats.Source](injector)
+		reporter     = do.MustInvoke[*prometheus.UnknownItemsReporter](injector)
+		selfReporter = do.MustInvoke[*prometheus.SelfReporter](injector)
+	)
+
+	return stats.NewRepo(source, reporter, selfReporter)
+}
+
+// NewRecordedStatsRepo records the snapshots read from the source if the recording is enabled.
+func NewRecordedStatsRepo(injector *do.Injector) (core.StatsRepo, error) {
+	var (
+		cfg           = do.MustInvoke[config.Config](injector)
+		repo          = do.MustInvoke[*stats.Repo](injector)
+		timeGenerator = do.MustInvoke[*timegen.TimeGenerator](injector)
+		logger        = do.MustInvoke[logrus.FieldLogger](injector)
+	)
+
+	if cfg.Recorder.Path == "" {
+		return repo, nil
+	}
 
-	return stats.NewRepo(timeGenerator)
+	return replay.NewRecorder(repo, timeGenerator, cfg.Recorder, logger)
 }
 
 func NewSingleflightStatsRepo(injector *do.Injector) (*stats.SingleflightRepo, error) {
-	baseRepo := do.MustInvoke[*stats.Repo](injector)
+	var (
+		baseRepo     = do.MustInvoke[core.StatsRepo](injector)
+		selfReporter = do.MustInvoke[*prometheus.SelfReporter](injector)
+	)
 
-	return stats.NewSingleflightRepo(baseRepo)
+	return stats.NewSingleflightRepo(baseRepo, selfReporter)
 }
 
 func NewCachedStatsRepo(injector *do.Injector) (*stats.CachedRepo, error) {
 	var (
 		cfg              = do.MustInvoke[config.Config](injector)
 		singleflightRepo = do.MustInvoke[*stats.SingleflightRepo](injector)
+		timeGenerator    = do.MustInvoke[*timegen.TimeGenerator](injector)
+		selfReporter     = do.MustInvoke[*prometheus.SelfReporter](injector)
 	)
 
-	return stats.NewCachedRepo(singleflightRepo, cfg.CachedRepo)
+	return stats.NewCachedRepo(singleflightRepo, timeGenerator, selfReporter, cfg.CachedRepo)
 }
 
 func NewRouter(injector *do.Injector) (*http.Router, error) {
@@ -86,18 +230,114 @@ func NewLogger(injector *do.Injector) (logrus.FieldLogger, error) {
 
 func NewHTTPServer(ctx context.Context) func(injector *do.Injector) (*http.Server, error) {
 	return func(injector *do.Injector) (*http.Server, error) {
+		var (
+			cfg             = do.MustInvoke[config.Config](injector)
+			router          = do.MustInvoke[*http.Router](injector)
+			sensorsRegistry = do.MustInvoke[*prometheusclient.Registry](injector)
+			logger          = do.MustInvoke[logrus.FieldLogger](injector)
+		)
+
+		return http.NewServer(ctx, cfg.HTTPServer, router, prometheusclient.DefaultRegisterer, sensorsRegistry, logger)
+	}
+}
+
+func NewGRPCHandler(injector *do.Injector) (*grpc.Handler, error) {
+	service := do.MustInvoke[*core.Service](injector)
+
+	return grpc.NewHandler(service)
+}
+
+func NewGRPCServer(ctx context.Context) func(injector *do.Injector) (*grpc.Server, error) {
+	return func(injector *do.Injector) (*grpc.Server, error) {
+		var (
+			cfg     = do.MustInvoke[config.Config](injector)
+			handler = do.MustInvoke[*grpc.Handler](injector)
+			logger  = do.MustInvoke[logrus.FieldLogger](injector)
+		)
+
+		// the gRPC server is secured the same way as the HTTP one
+		tlsConfig, err := http.NewTLSConfig(cfg.HTTPServer.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("tls config: %w", err)
+		}
+		security := grpc.Security{
+			TLS:        tlsConfig,
+			AuthTokens: cfg.HTTPServer.AuthTokens,
+		}
+
+		return grpc.NewServer(ctx, cfg.GRPCServer, security, handler, logger)
+	}
+}
+
+func NewAdminServer(ctx context.Context) func(injector *do.Injector) (*admin.Server, error) {
+	return func(injector *do.Injector) (*admin.Server, error) {
 		var (
 			cfg    = do.MustInvoke[config.Config](injector)
-			router = do.MustInvoke[*http.Router](injector)
 			logger = do.MustInvoke[logrus.FieldLogger](injector)
 		)
 
-		return http.NewServer(ctx, cfg.HTTPServer, router, logger)
+		return admin.NewServer(ctx, cfg.AdminServer, prometheusclient.DefaultGatherer, logger)
 	}
 }
 
+// NewSensorsRegistry provides the registry of the sensor metrics served by the API server,
+// the default registry keeps the metrics of picker itself for the admin server.
+func NewSensorsRegistry(_ *do.Injector) (*prometheusclient.Registry, error) {
+	return prometheusclient.NewRegistry(), nil
+}
+
+func NewStatsCollector(injector *do.Injector) (*prometheus.StatsCollector, error) {
+	var (
+		cfg             = do.MustInvoke[config.Config](injector)
+		cachedStatsRepo = do.MustInvoke[*stats.CachedRepo](injector)
+		sensorsRegistry = do.MustInvoke[*prometheusclient.Registry](injector)
+	)
+
+	collector, err := prometheus.NewStatsCollector(cachedStatsRepo, cfg.Metrics)
+	if err != nil {
+		return nil, fmt.Errorf("create stats collector: %w", err)
+	}
+	if err = collector.Register(sensorsRegistry); err != nil {
+		return nil, fmt.Errorf("register stats collector: %w", err)
+	}
+
+	return collector, nil
+}
+
+func NewStatsHub(injector *do.Injector) (*core.StatsHub, error) {
+	var (
+		cfg             = do.MustInvoke[config.Config](injector)
+		cachedStatsRepo = do.MustInvoke[*stats.CachedRepo](injector)
+	)
+
+	return core.NewStatsHub(cachedStatsRepo, cfg.Stream)
+}
+
+// NewHistoryRepo samples the cached stats, so the sampling shares the reads with the requests.
+func NewHistoryRepo(injector *do.Injector) (*history.Repo, error) {
+	var (
+		cfg             = do.MustInvoke[config.Config](injector)
+		cachedStatsRepo = do.MustInvoke[*stats.CachedRepo](injector)
+		timeGenerator   = do.MustInvoke[*timegen.TimeGenerator](injector)
+		logger          = do.MustInvoke[logrus.FieldLogger](injector)
+	)
+
+	return history.NewRepo(cachedStatsRepo, timeGenerator, cfg.History, logger)
+}
+
+func NewThresholds(injector *do.Injector) (core.Thresholds, error) {
+	cfg := do.MustInvoke[config.Config](injector)
+
+	return threshold.Load(cfg.Thresholds)
+}
+
 func NewCoreService(injector *do.Injector) (*core.Service, error) {
-	cachedStatsRepo := do.MustInvoke[*stats.CachedRepo](injector)
+	var (
+		cachedStatsRepo = do.MustInvoke[*stats.CachedRepo](injector)
+		statsHub        = do.MustInvoke[*core.StatsHub](injector)
+		historyRepo     = do.MustInvoke[*history.Repo](injector)
+		thresholds      = do.MustInvoke[core.Thresholds](injector)
+	)
 
-	return core.NewService(cachedStatsRepo)
+	return core.NewService(cachedStatsRepo, statsHub, historyRepo, thresholds)
 }
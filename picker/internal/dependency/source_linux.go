@@ -0,0 +1,20 @@
+package dependency
+
+import (
+	"github.com/genvmoroz/win-stats/picker/internal/config"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/hwmon"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/stats"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/timegen"
+	"github.com/samber/do"
+)
+
+func registerPlatformSources(injector *do.Injector, registry *stats.Registry) error {
+	return registry.Register(stats.HwmonBackend, func() (stats.Source, error) {
+		var (
+			cfg           = do.MustInvoke[config.Config](injector)
+			timeGenerator = do.MustInvoke[*timegen.TimeGenerator](injector)
+		)
+
+		return hwmon.NewRepo(cfg.Hwmon, timeGenerator)
+	})
+}
@@ -0,0 +1,13 @@
+//go:build !windows && !linux
+
+package dependency
+
+import (
+	"github.com/genvmoroz/win-stats/picker/internal/repository/stats"
+	"github.com/samber/do"
+)
+
+// registerPlatformSources registers nothing, there is no native sensor backend for this platform.
+func registerPlatformSources(_ *do.Injector, _ *stats.Registry) error {
+	return nil
+}
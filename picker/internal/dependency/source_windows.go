@@ -0,0 +1,17 @@
+package dependency
+
+import (
+	"github.com/genvmoroz/win-stats/picker/internal/repository/stats"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/timegen"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/wmi"
+	"github.com/genvmoroz/win-stats/picker/pkg/ohm"
+	"github.com/samber/do"
+)
+
+func registerPlatformSources(injector *do.Injector, registry *stats.Registry) error {
+	return registry.Register(stats.WMIBackend, func() (stats.Source, error) {
+		timeGenerator := do.MustInvoke[*timegen.TimeGenerator](injector)
+
+		return wmi.NewRepo(ohm.NewRepo(), timeGenerator)
+	})
+}
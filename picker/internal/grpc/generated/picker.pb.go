@@ -0,0 +1,1287 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: picker/v1/picker.proto
+
+package pickerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type HardwareType int32
+
+const (
+	HardwareType_HARDWARE_TYPE_UNSPECIFIED         HardwareType = 0
+	HardwareType_HARDWARE_TYPE_SUPER_IO            HardwareType = 1
+	HardwareType_HARDWARE_TYPE_CPU                 HardwareType = 2
+	HardwareType_HARDWARE_TYPE_GPU                 HardwareType = 3
+	HardwareType_HARDWARE_TYPE_T_BALANCER          HardwareType = 4
+	HardwareType_HARDWARE_TYPE_HEAT_MASTER         HardwareType = 5
+	HardwareType_HARDWARE_TYPE_HDD                 HardwareType = 6
+	HardwareType_HARDWARE_TYPE_RAM                 HardwareType = 7
+	HardwareType_HARDWARE_TYPE_NETWORK             HardwareType = 8
+	HardwareType_HARDWARE_TYPE_MEMORY              HardwareType = 9
+	HardwareType_HARDWARE_TYPE_STORAGE             HardwareType = 10
+	HardwareType_HARDWARE_TYPE_MOTHERBOARD         HardwareType = 11
+	HardwareType_HARDWARE_TYPE_BATTERY             HardwareType = 12
+	HardwareType_HARDWARE_TYPE_COOLER              HardwareType = 13
+	HardwareType_HARDWARE_TYPE_EMBEDDED_CONTROLLER HardwareType = 14
+	HardwareType_HARDWARE_TYPE_PSU                 HardwareType = 15
+)
+
+// Enum value maps for HardwareType.
+var (
+	HardwareType_name = map[int32]string{
+		0:  "HARDWARE_TYPE_UNSPECIFIED",
+		1:  "HARDWARE_TYPE_SUPER_IO",
+		2:  "HARDWARE_TYPE_CPU",
+		3:  "HARDWARE_TYPE_GPU",
+		4:  "HARDWARE_TYPE_T_BALANCER",
+		5:  "HARDWARE_TYPE_HEAT_MASTER",
+		6:  "HARDWARE_TYPE_HDD",
+		7:  "HARDWARE_TYPE_RAM",
+		8:  "HARDWARE_TYPE_NETWORK",
+		9:  "HARDWARE_TYPE_MEMORY",
+		10: "HARDWARE_TYPE_STORAGE",
+		11: "HARDWARE_TYPE_MOTHERBOARD",
+		12: "HARDWARE_TYPE_BATTERY",
+		13: "HARDWARE_TYPE_COOLER",
+		14: "HARDWARE_TYPE_EMBEDDED_CONTROLLER",
+		15: "HARDWARE_TYPE_PSU",
+	}
+	HardwareType_value = map[string]int32{
+		"HARDWARE_TYPE_UNSPECIFIED":         0,
+		"HARDWARE_TYPE_SUPER_IO":            1,
+		"HARDWARE_TYPE_CPU":                 2,
+		"HARDWARE_TYPE_GPU":                 3,
+		"HARDWARE_TYPE_T_BALANCER":          4,
+		"HARDWARE_TYPE_HEAT_MASTER":         5,
+		"HARDWARE_TYPE_HDD":                 6,
+		"HARDWARE_TYPE_RAM":                 7,
+		"HARDWARE_TYPE_NETWORK":             8,
+		"HARDWARE_TYPE_MEMORY":              9,
+		"HARDWARE_TYPE_STORAGE":             10,
+		"HARDWARE_TYPE_MOTHERBOARD":         11,
+		"HARDWARE_TYPE_BATTERY":             12,
+		"HARDWARE_TYPE_COOLER":              13,
+		"HARDWARE_TYPE_EMBEDDED_CONTROLLER": 14,
+		"HARDWARE_TYPE_PSU":                 15,
+	}
+)
+
+func (x HardwareType) Enum() *HardwareType {
+	p := new(HardwareType)
+	*p = x
+	return p
+}
+
+func (x HardwareType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HardwareType) Descriptor() protoreflect.EnumDescriptor {
+	return file_picker_v1_picker_proto_enumTypes[0].Descriptor()
+}
+
+func (HardwareType) Type() protoreflect.EnumType {
+	return &file_picker_v1_picker_proto_enumTypes[0]
+}
+
+func (x HardwareType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HardwareType.Descriptor instead.
+func (HardwareType) EnumDescriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{0}
+}
+
+type SensorType int32
+
+const (
+	SensorType_SENSOR_TYPE_UNSPECIFIED  SensorType = 0
+	SensorType_SENSOR_TYPE_VOLTAGE      SensorType = 1
+	SensorType_SENSOR_TYPE_CLOCK        SensorType = 2
+	SensorType_SENSOR_TYPE_TEMPERATURE  SensorType = 3
+	SensorType_SENSOR_TYPE_LOAD         SensorType = 4
+	SensorType_SENSOR_TYPE_FAN          SensorType = 5
+	SensorType_SENSOR_TYPE_FLOW         SensorType = 6
+	SensorType_SENSOR_TYPE_CONTROL      SensorType = 7
+	SensorType_SENSOR_TYPE_LEVEL        SensorType = 8
+	SensorType_SENSOR_TYPE_POWER        SensorType = 9
+	SensorType_SENSOR_TYPE_SMALL_DATA   SensorType = 10
+	SensorType_SENSOR_TYPE_THROUGHPUT   SensorType = 11
+	SensorType_SENSOR_TYPE_DATA         SensorType = 12
+	SensorType_SENSOR_TYPE_FACTOR       SensorType = 13
+	SensorType_SENSOR_TYPE_ENERGY       SensorType = 14
+	SensorType_SENSOR_TYPE_CURRENT      SensorType = 15
+	SensorType_SENSOR_TYPE_FREQUENCY    SensorType = 16
+	SensorType_SENSOR_TYPE_TIME_SPAN    SensorType = 17
+	SensorType_SENSOR_TYPE_NOISE        SensorType = 18
+	SensorType_SENSOR_TYPE_CONDUCTIVITY SensorType = 19
+	SensorType_SENSOR_TYPE_HUMIDITY     SensorType = 20
+)
+
+// Enum value maps for SensorType.
+var (
+	SensorType_name = map[int32]string{
+		0:  "SENSOR_TYPE_UNSPECIFIED",
+		1:  "SENSOR_TYPE_VOLTAGE",
+		2:  "SENSOR_TYPE_CLOCK",
+		3:  "SENSOR_TYPE_TEMPERATURE",
+		4:  "SENSOR_TYPE_LOAD",
+		5:  "SENSOR_TYPE_FAN",
+		6:  "SENSOR_TYPE_FLOW",
+		7:  "SENSOR_TYPE_CONTROL",
+		8:  "SENSOR_TYPE_LEVEL",
+		9:  "SENSOR_TYPE_POWER",
+		10: "SENSOR_TYPE_SMALL_DATA",
+		11: "SENSOR_TYPE_THROUGHPUT",
+		12: "SENSOR_TYPE_DATA",
+		13: "SENSOR_TYPE_FACTOR",
+		14: "SENSOR_TYPE_ENERGY",
+		15: "SENSOR_TYPE_CURRENT",
+		16: "SENSOR_TYPE_FREQUENCY",
+		17: "SENSOR_TYPE_TIME_SPAN",
+		18: "SENSOR_TYPE_NOISE",
+		19: "SENSOR_TYPE_CONDUCTIVITY",
+		20: "SENSOR_TYPE_HUMIDITY",
+	}
+	SensorType_value = map[string]int32{
+		"SENSOR_TYPE_UNSPECIFIED":  0,
+		"SENSOR_TYPE_VOLTAGE":      1,
+		"SENSOR_TYPE_CLOCK":        2,
+		"SENSOR_TYPE_TEMPERATURE":  3,
+		"SENSOR_TYPE_LOAD":         4,
+		"SENSOR_TYPE_FAN":          5,
+		"SENSOR_TYPE_FLOW":         6,
+		"SENSOR_TYPE_CONTROL":      7,
+		"SENSOR_TYPE_LEVEL":        8,
+		"SENSOR_TYPE_POWER":        9,
+		"SENSOR_TYPE_SMALL_DATA":   10,
+		"SENSOR_TYPE_THROUGHPUT":   11,
+		"SENSOR_TYPE_DATA":         12,
+		"SENSOR_TYPE_FACTOR":       13,
+		"SENSOR_TYPE_ENERGY":       14,
+		"SENSOR_TYPE_CURRENT":      15,
+		"SENSOR_TYPE_FREQUENCY":    16,
+		"SENSOR_TYPE_TIME_SPAN":    17,
+		"SENSOR_TYPE_NOISE":        18,
+		"SENSOR_TYPE_CONDUCTIVITY": 19,
+		"SENSOR_TYPE_HUMIDITY":     20,
+	}
+)
+
+func (x SensorType) Enum() *SensorType {
+	p := new(SensorType)
+	*p = x
+	return p
+}
+
+func (x SensorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SensorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_picker_v1_picker_proto_enumTypes[1].Descriptor()
+}
+
+func (SensorType) Type() protoreflect.EnumType {
+	return &file_picker_v1_picker_proto_enumTypes[1]
+}
+
+func (x SensorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SensorType.Descriptor instead.
+func (SensorType) EnumDescriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{1}
+}
+
+type Unit int32
+
+const (
+	Unit_UNIT_UNSPECIFIED            Unit = 0
+	Unit_UNIT_VOLT                   Unit = 1
+	Unit_UNIT_MEGAHERTZ              Unit = 2
+	Unit_UNIT_CELSIUS                Unit = 3
+	Unit_UNIT_PERCENTAGE             Unit = 4
+	Unit_UNIT_REVOLUTIONS_PER_MINUTE Unit = 5
+	Unit_UNIT_LITERS_PER_HOUR        Unit = 6
+	Unit_UNIT_WATTS                  Unit = 7
+	Unit_UNIT_GIGABYTES              Unit = 8
+	Unit_UNIT_MEGABYTES              Unit = 9
+	Unit_UNIT_KILOBYTES_PER_SECOND   Unit = 10
+	Unit_UNIT_AMPERES                Unit = 11
+	Unit_UNIT_MILLIWATT_HOURS        Unit = 12
+	Unit_UNIT_BYTES_PER_SECOND       Unit = 13
+	Unit_UNIT_MEGABYTES_PER_SECOND   Unit = 14
+	Unit_UNIT_TERABYTES              Unit = 15
+	Unit_UNIT_FAHRENHEIT             Unit = 16
+	Unit_UNIT_GIGAHERTZ              Unit = 17
+	Unit_UNIT_RATIO                  Unit = 18
+	Unit_UNIT_HERTZ                  Unit = 19
+	Unit_UNIT_SECONDS                Unit = 20
+	// A-weighted decibels.
+	Unit_UNIT_DECIBELS                    Unit = 21
+	Unit_UNIT_MICROSIEMENS_PER_CENTIMETER Unit = 22
+)
+
+// Enum value maps for Unit.
+var (
+	Unit_name = map[int32]string{
+		0:  "UNIT_UNSPECIFIED",
+		1:  "UNIT_VOLT",
+		2:  "UNIT_MEGAHERTZ",
+		3:  "UNIT_CELSIUS",
+		4:  "UNIT_PERCENTAGE",
+		5:  "UNIT_REVOLUTIONS_PER_MINUTE",
+		6:  "UNIT_LITERS_PER_HOUR",
+		7:  "UNIT_WATTS",
+		8:  "UNIT_GIGABYTES",
+		9:  "UNIT_MEGABYTES",
+		10: "UNIT_KILOBYTES_PER_SECOND",
+		11: "UNIT_AMPERES",
+		12: "UNIT_MILLIWATT_HOURS",
+		13: "UNIT_BYTES_PER_SECOND",
+		14: "UNIT_MEGABYTES_PER_SECOND",
+		15: "UNIT_TERABYTES",
+		16: "UNIT_FAHRENHEIT",
+		17: "UNIT_GIGAHERTZ",
+		18: "UNIT_RATIO",
+		19: "UNIT_HERTZ",
+		20: "UNIT_SECONDS",
+		21: "UNIT_DECIBELS",
+		22: "UNIT_MICROSIEMENS_PER_CENTIMETER",
+	}
+	Unit_value = map[string]int32{
+		"UNIT_UNSPECIFIED":                 0,
+		"UNIT_VOLT":                        1,
+		"UNIT_MEGAHERTZ":                   2,
+		"UNIT_CELSIUS":                     3,
+		"UNIT_PERCENTAGE":                  4,
+		"UNIT_REVOLUTIONS_PER_MINUTE":      5,
+		"UNIT_LITERS_PER_HOUR":             6,
+		"UNIT_WATTS":                       7,
+		"UNIT_GIGABYTES":                   8,
+		"UNIT_MEGABYTES":                   9,
+		"UNIT_KILOBYTES_PER_SECOND":        10,
+		"UNIT_AMPERES":                     11,
+		"UNIT_MILLIWATT_HOURS":             12,
+		"UNIT_BYTES_PER_SECOND":            13,
+		"UNIT_MEGABYTES_PER_SECOND":        14,
+		"UNIT_TERABYTES":                   15,
+		"UNIT_FAHRENHEIT":                  16,
+		"UNIT_GIGAHERTZ":                   17,
+		"UNIT_RATIO":                       18,
+		"UNIT_HERTZ":                       19,
+		"UNIT_SECONDS":                     20,
+		"UNIT_DECIBELS":                    21,
+		"UNIT_MICROSIEMENS_PER_CENTIMETER": 22,
+	}
+)
+
+func (x Unit) Enum() *Unit {
+	p := new(Unit)
+	*p = x
+	return p
+}
+
+func (x Unit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Unit) Descriptor() protoreflect.EnumDescriptor {
+	return file_picker_v1_picker_proto_enumTypes[2].Descriptor()
+}
+
+func (Unit) Type() protoreflect.EnumType {
+	return &file_picker_v1_picker_proto_enumTypes[2]
+}
+
+func (x Unit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Unit.Descriptor instead.
+func (Unit) EnumDescriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{2}
+}
+
+type WatchEventKind int32
+
+const (
+	WatchEventKind_WATCH_EVENT_KIND_UNSPECIFIED WatchEventKind = 0
+	WatchEventKind_WATCH_EVENT_KIND_SNAPSHOT    WatchEventKind = 1
+	WatchEventKind_WATCH_EVENT_KIND_CHANGES     WatchEventKind = 2
+	// The stats couldn't be read, the stream goes on.
+	WatchEventKind_WATCH_EVENT_KIND_ERROR WatchEventKind = 3
+)
+
+// Enum value maps for WatchEventKind.
+var (
+	WatchEventKind_name = map[int32]string{
+		0: "WATCH_EVENT_KIND_UNSPECIFIED",
+		1: "WATCH_EVENT_KIND_SNAPSHOT",
+		2: "WATCH_EVENT_KIND_CHANGES",
+		3: "WATCH_EVENT_KIND_ERROR",
+	}
+	WatchEventKind_value = map[string]int32{
+		"WATCH_EVENT_KIND_UNSPECIFIED": 0,
+		"WATCH_EVENT_KIND_SNAPSHOT":    1,
+		"WATCH_EVENT_KIND_CHANGES":     2,
+		"WATCH_EVENT_KIND_ERROR":       3,
+	}
+)
+
+func (x WatchEventKind) Enum() *WatchEventKind {
+	p := new(WatchEventKind)
+	*p = x
+	return p
+}
+
+func (x WatchEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_picker_v1_picker_proto_enumTypes[3].Descriptor()
+}
+
+func (WatchEventKind) Type() protoreflect.EnumType {
+	return &file_picker_v1_picker_proto_enumTypes[3]
+}
+
+func (x WatchEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchEventKind.Descriptor instead.
+func (WatchEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{3}
+}
+
+type Hardware struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the hardware this one is attached to, empty for the top-level hardware.
+	ParentId string       `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name     string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type     HardwareType `protobuf:"varint,4,opt,name=type,proto3,enum=picker.v1.HardwareType" json:"type,omitempty"`
+	// Type reported by the sensor backend, set only if the type is unspecified.
+	RawType string    `protobuf:"bytes,5,opt,name=raw_type,json=rawType,proto3" json:"raw_type,omitempty"`
+	Sensors []*Sensor `protobuf:"bytes,6,rep,name=sensors,proto3" json:"sensors,omitempty"`
+	// Type of the hardware as reported by the sensor backend, e.g. GpuNvidia, tells apart the hardware of the same type.
+	Subtype       string `protobuf:"bytes,7,opt,name=subtype,proto3" json:"subtype,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hardware) Reset() {
+	*x = Hardware{}
+	mi := &file_picker_v1_picker_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hardware) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hardware) ProtoMessage() {}
+
+func (x *Hardware) ProtoReflect() protoreflect.Message {
+	mi := &file_picker_v1_picker_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hardware.ProtoReflect.Descriptor instead.
+func (*Hardware) Descriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Hardware) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Hardware) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Hardware) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Hardware) GetType() HardwareType {
+	if x != nil {
+		return x.Type
+	}
+	return HardwareType_HARDWARE_TYPE_UNSPECIFIED
+}
+
+func (x *Hardware) GetRawType() string {
+	if x != nil {
+		return x.RawType
+	}
+	return ""
+}
+
+func (x *Hardware) GetSensors() []*Sensor {
+	if x != nil {
+		return x.Sensors
+	}
+	return nil
+}
+
+func (x *Hardware) GetSubtype() string {
+	if x != nil {
+		return x.Subtype
+	}
+	return ""
+}
+
+type Sensor struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HardwareId string                 `protobuf:"bytes,2,opt,name=hardware_id,json=hardwareId,proto3" json:"hardware_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type       SensorType             `protobuf:"varint,4,opt,name=type,proto3,enum=picker.v1.SensorType" json:"type,omitempty"`
+	// Type reported by the sensor backend, set only if the type is unspecified.
+	RawType string `protobuf:"bytes,5,opt,name=raw_type,json=rawType,proto3" json:"raw_type,omitempty"`
+	// Position of the sensor among the sensors of the same type of the hardware.
+	Index         int32        `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	Unit          Unit         `protobuf:"varint,7,opt,name=unit,proto3,enum=picker.v1.Unit" json:"unit,omitempty"`
+	Value         *SensorValue `protobuf:"bytes,8,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sensor) Reset() {
+	*x = Sensor{}
+	mi := &file_picker_v1_picker_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sensor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sensor) ProtoMessage() {}
+
+func (x *Sensor) ProtoReflect() protoreflect.Message {
+	mi := &file_picker_v1_picker_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sensor.ProtoReflect.Descriptor instead.
+func (*Sensor) Descriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Sensor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Sensor) GetHardwareId() string {
+	if x != nil {
+		return x.HardwareId
+	}
+	return ""
+}
+
+func (x *Sensor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Sensor) GetType() SensorType {
+	if x != nil {
+		return x.Type
+	}
+	return SensorType_SENSOR_TYPE_UNSPECIFIED
+}
+
+func (x *Sensor) GetRawType() string {
+	if x != nil {
+		return x.RawType
+	}
+	return ""
+}
+
+func (x *Sensor) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Sensor) GetUnit() Unit {
+	if x != nil {
+		return x.Unit
+	}
+	return Unit_UNIT_UNSPECIFIED
+}
+
+func (x *Sensor) GetValue() *SensorValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SensorValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Value float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Lowest and highest values observed since the sensor backend was started.
+	Min           float64                `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SensorValue) Reset() {
+	*x = SensorValue{}
+	mi := &file_picker_v1_picker_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorValue) ProtoMessage() {}
+
+func (x *SensorValue) ProtoReflect() protoreflect.Message {
+	mi := &file_picker_v1_picker_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorValue.ProtoReflect.Descriptor instead.
+func (*SensorValue) Descriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SensorValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SensorValue) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *SensorValue) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *SensorValue) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// StatsFilter narrows the stats, the values of a single field are combined by OR, the fields are combined by AND.
+type StatsFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HardwareTypes []HardwareType         `protobuf:"varint,1,rep,packed,name=hardware_types,json=hardwareTypes,proto3,enum=picker.v1.HardwareType" json:"hardware_types,omitempty"`
+	SensorTypes   []SensorType           `protobuf:"varint,2,rep,packed,name=sensor_types,json=sensorTypes,proto3,enum=picker.v1.SensorType" json:"sensor_types,omitempty"`
+	HardwareIds   []string               `protobuf:"bytes,3,rep,name=hardware_ids,json=hardwareIds,proto3" json:"hardware_ids,omitempty"`
+	SensorIds     []string               `protobuf:"bytes,4,rep,name=sensor_ids,json=sensorIds,proto3" json:"sensor_ids,omitempty"`
+	// Patterns of the sensor names with the '*' and '?' wildcards, case-insensitive.
+	NamePatterns  []string `protobuf:"bytes,5,rep,name=name_patterns,json=namePatterns,proto3" json:"name_patterns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsFilter) Reset() {
+	*x = StatsFilter{}
+	mi := &file_picker_v1_picker_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsFilter) ProtoMessage() {}
+
+func (x *StatsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_picker_v1_picker_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsFilter.ProtoReflect.Descriptor instead.
+func (*StatsFilter) Descriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StatsFilter) GetHardwareTypes() []HardwareType {
+	if x != nil {
+		return x.HardwareTypes
+	}
+	return nil
+}
+
+func (x *StatsFilter) GetSensorTypes() []SensorType {
+	if x != nil {
+		return x.SensorTypes
+	}
+	return nil
+}
+
+func (x *StatsFilter) GetHardwareIds() []string {
+	if x != nil {
+		return x.HardwareIds
+	}
+	return nil
+}
+
+func (x *StatsFilter) GetSensorIds() []string {
+	if x != nil {
+		return x.SensorIds
+	}
+	return nil
+}
+
+func (x *StatsFilter) GetNamePatterns() []string {
+	if x != nil {
+		return x.NamePatterns
+	}
+	return nil
+}
+
+type GetStatsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Preferred units, the same as the units query parameter of the HTTP API, e.g. fahrenheit or ghz.
+	Units         []string `protobuf:"bytes,2,rep,name=units,proto3" json:"units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_picker_v1_picker_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picker_v1_picker_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetStatsRequest) GetUnits() []string {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+type GetStatsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Hardware []*Hardware            `protobuf:"bytes,1,rep,name=hardware,proto3" json:"hardware,omitempty"`
+	// Issues that didn't fail the request, e.g. the hardware or sensors of an unknown type.
+	Warnings      []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	mi := &file_picker_v1_picker_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_picker_v1_picker_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetStatsResponse) GetHardware() []*Hardware {
+	if x != nil {
+		return x.Hardware
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ListHardwareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHardwareRequest) Reset() {
+	*x = ListHardwareRequest{}
+	mi := &file_picker_v1_picker_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHardwareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHardwareRequest) ProtoMessage() {}
+
+func (x *ListHardwareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picker_v1_picker_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHardwareRequest.ProtoReflect.Descriptor instead.
+func (*ListHardwareRequest) Descriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{6}
+}
+
+type ListHardwareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hardware      []*Hardware            `protobuf:"bytes,1,rep,name=hardware,proto3" json:"hardware,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHardwareResponse) Reset() {
+	*x = ListHardwareResponse{}
+	mi := &file_picker_v1_picker_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHardwareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHardwareResponse) ProtoMessage() {}
+
+func (x *ListHardwareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_picker_v1_picker_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHardwareResponse.ProtoReflect.Descriptor instead.
+func (*ListHardwareResponse) Descriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListHardwareResponse) GetHardware() []*Hardware {
+	if x != nil {
+		return x.Hardware
+	}
+	return nil
+}
+
+type WatchSensorsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *StatsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Units  []string               `protobuf:"bytes,2,rep,name=units,proto3" json:"units,omitempty"`
+	// Minimal time between the events, one second if unset.
+	Interval *durationpb.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Sends only the sensors that are new or whose values changed after the first snapshot.
+	// The snapshot is sent instead once a sensor is gone, the changes can't report the removed sensors.
+	ChangesOnly bool `protobuf:"varint,4,opt,name=changes_only,json=changesOnly,proto3" json:"changes_only,omitempty"`
+	// ID of the last event received before reconnecting, the changes are resumed from it if it is recent enough.
+	LastEventId   string `protobuf:"bytes,5,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSensorsRequest) Reset() {
+	*x = WatchSensorsRequest{}
+	mi := &file_picker_v1_picker_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSensorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSensorsRequest) ProtoMessage() {}
+
+func (x *WatchSensorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picker_v1_picker_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSensorsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSensorsRequest) Descriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchSensorsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchSensorsRequest) GetUnits() []string {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *WatchSensorsRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *WatchSensorsRequest) GetChangesOnly() bool {
+	if x != nil {
+		return x.ChangesOnly
+	}
+	return false
+}
+
+func (x *WatchSensorsRequest) GetLastEventId() string {
+	if x != nil {
+		return x.LastEventId
+	}
+	return ""
+}
+
+type WatchSensorsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EventId  string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Kind     WatchEventKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=picker.v1.WatchEventKind" json:"kind,omitempty"`
+	Hardware []*Hardware            `protobuf:"bytes,3,rep,name=hardware,proto3" json:"hardware,omitempty"`
+	Warnings []string               `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// Set only for the error events.
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSensorsResponse) Reset() {
+	*x = WatchSensorsResponse{}
+	mi := &file_picker_v1_picker_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSensorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSensorsResponse) ProtoMessage() {}
+
+func (x *WatchSensorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_picker_v1_picker_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSensorsResponse.ProtoReflect.Descriptor instead.
+func (*WatchSensorsResponse) Descriptor() ([]byte, []int) {
+	return file_picker_v1_picker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchSensorsResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WatchSensorsResponse) GetKind() WatchEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return WatchEventKind_WATCH_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *WatchSensorsResponse) GetHardware() []*Hardware {
+	if x != nil {
+		return x.Hardware
+	}
+	return nil
+}
+
+func (x *WatchSensorsResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *WatchSensorsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_picker_v1_picker_proto protoreflect.FileDescriptor
+
+const file_picker_v1_picker_proto_rawDesc = "" +
+	"\n" +
+	"\x16picker/v1/picker.proto\x12\tpicker.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xda\x01\n" +
+	"\bHardware\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12+\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x17.picker.v1.HardwareTypeR\x04type\x12\x19\n" +
+	"\braw_type\x18\x05 \x01(\tR\arawType\x12+\n" +
+	"\asensors\x18\x06 \x03(\v2\x11.picker.v1.SensorR\asensors\x12\x18\n" +
+	"\asubtype\x18\a \x01(\tR\asubtype\"\xfc\x01\n" +
+	"\x06Sensor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vhardware_id\x18\x02 \x01(\tR\n" +
+	"hardwareId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12)\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x15.picker.v1.SensorTypeR\x04type\x12\x19\n" +
+	"\braw_type\x18\x05 \x01(\tR\arawType\x12\x14\n" +
+	"\x05index\x18\x06 \x01(\x05R\x05index\x12#\n" +
+	"\x04unit\x18\a \x01(\x0e2\x0f.picker.v1.UnitR\x04unit\x12,\n" +
+	"\x05value\x18\b \x01(\v2\x16.picker.v1.SensorValueR\x05value\"\x81\x01\n" +
+	"\vSensorValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x10\n" +
+	"\x03min\x18\x02 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\x01R\x03max\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xee\x01\n" +
+	"\vStatsFilter\x12>\n" +
+	"\x0ehardware_types\x18\x01 \x03(\x0e2\x17.picker.v1.HardwareTypeR\rhardwareTypes\x128\n" +
+	"\fsensor_types\x18\x02 \x03(\x0e2\x15.picker.v1.SensorTypeR\vsensorTypes\x12!\n" +
+	"\fhardware_ids\x18\x03 \x03(\tR\vhardwareIds\x12\x1d\n" +
+	"\n" +
+	"sensor_ids\x18\x04 \x03(\tR\tsensorIds\x12#\n" +
+	"\rname_patterns\x18\x05 \x03(\tR\fnamePatterns\"W\n" +
+	"\x0fGetStatsRequest\x12.\n" +
+	"\x06filter\x18\x01 \x01(\v2\x16.picker.v1.StatsFilterR\x06filter\x12\x14\n" +
+	"\x05units\x18\x02 \x03(\tR\x05units\"_\n" +
+	"\x10GetStatsResponse\x12/\n" +
+	"\bhardware\x18\x01 \x03(\v2\x13.picker.v1.HardwareR\bhardware\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\x15\n" +
+	"\x13ListHardwareRequest\"G\n" +
+	"\x14ListHardwareResponse\x12/\n" +
+	"\bhardware\x18\x01 \x03(\v2\x13.picker.v1.HardwareR\bhardware\"\xd9\x01\n" +
+	"\x13WatchSensorsRequest\x12.\n" +
+	"\x06filter\x18\x01 \x01(\v2\x16.picker.v1.StatsFilterR\x06filter\x12\x14\n" +
+	"\x05units\x18\x02 \x03(\tR\x05units\x125\n" +
+	"\binterval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12!\n" +
+	"\fchanges_only\x18\x04 \x01(\bR\vchangesOnly\x12\"\n" +
+	"\rlast_event_id\x18\x05 \x01(\tR\vlastEventId\"\xc3\x01\n" +
+	"\x14WatchSensorsResponse\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12-\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x19.picker.v1.WatchEventKindR\x04kind\x12/\n" +
+	"\bhardware\x18\x03 \x03(\v2\x13.picker.v1.HardwareR\bhardware\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error*\xc4\x03\n" +
+	"\fHardwareType\x12\x1d\n" +
+	"\x19HARDWARE_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16HARDWARE_TYPE_SUPER_IO\x10\x01\x12\x15\n" +
+	"\x11HARDWARE_TYPE_CPU\x10\x02\x12\x15\n" +
+	"\x11HARDWARE_TYPE_GPU\x10\x03\x12\x1c\n" +
+	"\x18HARDWARE_TYPE_T_BALANCER\x10\x04\x12\x1d\n" +
+	"\x19HARDWARE_TYPE_HEAT_MASTER\x10\x05\x12\x15\n" +
+	"\x11HARDWARE_TYPE_HDD\x10\x06\x12\x15\n" +
+	"\x11HARDWARE_TYPE_RAM\x10\a\x12\x19\n" +
+	"\x15HARDWARE_TYPE_NETWORK\x10\b\x12\x18\n" +
+	"\x14HARDWARE_TYPE_MEMORY\x10\t\x12\x19\n" +
+	"\x15HARDWARE_TYPE_STORAGE\x10\n" +
+	"\x12\x1d\n" +
+	"\x19HARDWARE_TYPE_MOTHERBOARD\x10\v\x12\x19\n" +
+	"\x15HARDWARE_TYPE_BATTERY\x10\f\x12\x18\n" +
+	"\x14HARDWARE_TYPE_COOLER\x10\r\x12%\n" +
+	"!HARDWARE_TYPE_EMBEDDED_CONTROLLER\x10\x0e\x12\x15\n" +
+	"\x11HARDWARE_TYPE_PSU\x10\x0f*\x9a\x04\n" +
+	"\n" +
+	"SensorType\x12\x1b\n" +
+	"\x17SENSOR_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SENSOR_TYPE_VOLTAGE\x10\x01\x12\x15\n" +
+	"\x11SENSOR_TYPE_CLOCK\x10\x02\x12\x1b\n" +
+	"\x17SENSOR_TYPE_TEMPERATURE\x10\x03\x12\x14\n" +
+	"\x10SENSOR_TYPE_LOAD\x10\x04\x12\x13\n" +
+	"\x0fSENSOR_TYPE_FAN\x10\x05\x12\x14\n" +
+	"\x10SENSOR_TYPE_FLOW\x10\x06\x12\x17\n" +
+	"\x13SENSOR_TYPE_CONTROL\x10\a\x12\x15\n" +
+	"\x11SENSOR_TYPE_LEVEL\x10\b\x12\x15\n" +
+	"\x11SENSOR_TYPE_POWER\x10\t\x12\x1a\n" +
+	"\x16SENSOR_TYPE_SMALL_DATA\x10\n" +
+	"\x12\x1a\n" +
+	"\x16SENSOR_TYPE_THROUGHPUT\x10\v\x12\x14\n" +
+	"\x10SENSOR_TYPE_DATA\x10\f\x12\x16\n" +
+	"\x12SENSOR_TYPE_FACTOR\x10\r\x12\x16\n" +
+	"\x12SENSOR_TYPE_ENERGY\x10\x0e\x12\x17\n" +
+	"\x13SENSOR_TYPE_CURRENT\x10\x0f\x12\x19\n" +
+	"\x15SENSOR_TYPE_FREQUENCY\x10\x10\x12\x19\n" +
+	"\x15SENSOR_TYPE_TIME_SPAN\x10\x11\x12\x15\n" +
+	"\x11SENSOR_TYPE_NOISE\x10\x12\x12\x1c\n" +
+	"\x18SENSOR_TYPE_CONDUCTIVITY\x10\x13\x12\x18\n" +
+	"\x14SENSOR_TYPE_HUMIDITY\x10\x14*\x86\x04\n" +
+	"\x04Unit\x12\x14\n" +
+	"\x10UNIT_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tUNIT_VOLT\x10\x01\x12\x12\n" +
+	"\x0eUNIT_MEGAHERTZ\x10\x02\x12\x10\n" +
+	"\fUNIT_CELSIUS\x10\x03\x12\x13\n" +
+	"\x0fUNIT_PERCENTAGE\x10\x04\x12\x1f\n" +
+	"\x1bUNIT_REVOLUTIONS_PER_MINUTE\x10\x05\x12\x18\n" +
+	"\x14UNIT_LITERS_PER_HOUR\x10\x06\x12\x0e\n" +
+	"\n" +
+	"UNIT_WATTS\x10\a\x12\x12\n" +
+	"\x0eUNIT_GIGABYTES\x10\b\x12\x12\n" +
+	"\x0eUNIT_MEGABYTES\x10\t\x12\x1d\n" +
+	"\x19UNIT_KILOBYTES_PER_SECOND\x10\n" +
+	"\x12\x10\n" +
+	"\fUNIT_AMPERES\x10\v\x12\x18\n" +
+	"\x14UNIT_MILLIWATT_HOURS\x10\f\x12\x19\n" +
+	"\x15UNIT_BYTES_PER_SECOND\x10\r\x12\x1d\n" +
+	"\x19UNIT_MEGABYTES_PER_SECOND\x10\x0e\x12\x12\n" +
+	"\x0eUNIT_TERABYTES\x10\x0f\x12\x13\n" +
+	"\x0fUNIT_FAHRENHEIT\x10\x10\x12\x12\n" +
+	"\x0eUNIT_GIGAHERTZ\x10\x11\x12\x0e\n" +
+	"\n" +
+	"UNIT_RATIO\x10\x12\x12\x0e\n" +
+	"\n" +
+	"UNIT_HERTZ\x10\x13\x12\x10\n" +
+	"\fUNIT_SECONDS\x10\x14\x12\x11\n" +
+	"\rUNIT_DECIBELS\x10\x15\x12$\n" +
+	" UNIT_MICROSIEMENS_PER_CENTIMETER\x10\x16*\x8b\x01\n" +
+	"\x0eWatchEventKind\x12 \n" +
+	"\x1cWATCH_EVENT_KIND_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19WATCH_EVENT_KIND_SNAPSHOT\x10\x01\x12\x1c\n" +
+	"\x18WATCH_EVENT_KIND_CHANGES\x10\x02\x12\x1a\n" +
+	"\x16WATCH_EVENT_KIND_ERROR\x10\x032\xf8\x01\n" +
+	"\rPickerService\x12C\n" +
+	"\bGetStats\x12\x1a.picker.v1.GetStatsRequest\x1a\x1b.picker.v1.GetStatsResponse\x12O\n" +
+	"\fListHardware\x12\x1e.picker.v1.ListHardwareRequest\x1a\x1f.picker.v1.ListHardwareResponse\x12Q\n" +
+	"\fWatchSensors\x12\x1e.picker.v1.WatchSensorsRequest\x1a\x1f.picker.v1.WatchSensorsResponse0\x01BHZFgithub.com/genvmoroz/win-stats/picker/internal/grpc/generated;pickerv1b\x06proto3"
+
+var (
+	file_picker_v1_picker_proto_rawDescOnce sync.Once
+	file_picker_v1_picker_proto_rawDescData []byte
+)
+
+func file_picker_v1_picker_proto_rawDescGZIP() []byte {
+	file_picker_v1_picker_proto_rawDescOnce.Do(func() {
+		file_picker_v1_picker_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_picker_v1_picker_proto_rawDesc), len(file_picker_v1_picker_proto_rawDesc)))
+	})
+	return file_picker_v1_picker_proto_rawDescData
+}
+
+var file_picker_v1_picker_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_picker_v1_picker_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_picker_v1_picker_proto_goTypes = []any{
+	(HardwareType)(0),             // 0: picker.v1.HardwareType
+	(SensorType)(0),               // 1: picker.v1.SensorType
+	(Unit)(0),                     // 2: picker.v1.Unit
+	(WatchEventKind)(0),           // 3: picker.v1.WatchEventKind
+	(*Hardware)(nil),              // 4: picker.v1.Hardware
+	(*Sensor)(nil),                // 5: picker.v1.Sensor
+	(*SensorValue)(nil),           // 6: picker.v1.SensorValue
+	(*StatsFilter)(nil),           // 7: picker.v1.StatsFilter
+	(*GetStatsRequest)(nil),       // 8: picker.v1.GetStatsRequest
+	(*GetStatsResponse)(nil),      // 9: picker.v1.GetStatsResponse
+	(*ListHardwareRequest)(nil),   // 10: picker.v1.ListHardwareRequest
+	(*ListHardwareResponse)(nil),  // 11: picker.v1.ListHardwareResponse
+	(*WatchSensorsRequest)(nil),   // 12: picker.v1.WatchSensorsRequest
+	(*WatchSensorsResponse)(nil),  // 13: picker.v1.WatchSensorsResponse
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 15: google.protobuf.Duration
+}
+var file_picker_v1_picker_proto_depIdxs = []int32{
+	0,  // 0: picker.v1.Hardware.type:type_name -> picker.v1.HardwareType
+	5,  // 1: picker.v1.Hardware.sensors:type_name -> picker.v1.Sensor
+	1,  // 2: picker.v1.Sensor.type:type_name -> picker.v1.SensorType
+	2,  // 3: picker.v1.Sensor.unit:type_name -> picker.v1.Unit
+	6,  // 4: picker.v1.Sensor.value:type_name -> picker.v1.SensorValue
+	14, // 5: picker.v1.SensorValue.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 6: picker.v1.StatsFilter.hardware_types:type_name -> picker.v1.HardwareType
+	1,  // 7: picker.v1.StatsFilter.sensor_types:type_name -> picker.v1.SensorType
+	7,  // 8: picker.v1.GetStatsRequest.filter:type_name -> picker.v1.StatsFilter
+	4,  // 9: picker.v1.GetStatsResponse.hardware:type_name -> picker.v1.Hardware
+	4,  // 10: picker.v1.ListHardwareResponse.hardware:type_name -> picker.v1.Hardware
+	7,  // 11: picker.v1.WatchSensorsRequest.filter:type_name -> picker.v1.StatsFilter
+	15, // 12: picker.v1.WatchSensorsRequest.interval:type_name -> google.protobuf.Duration
+	3,  // 13: picker.v1.WatchSensorsResponse.kind:type_name -> picker.v1.WatchEventKind
+	4,  // 14: picker.v1.WatchSensorsResponse.hardware:type_name -> picker.v1.Hardware
+	8,  // 15: picker.v1.PickerService.GetStats:input_type -> picker.v1.GetStatsRequest
+	10, // 16: picker.v1.PickerService.ListHardware:input_type -> picker.v1.ListHardwareRequest
+	12, // 17: picker.v1.PickerService.WatchSensors:input_type -> picker.v1.WatchSensorsRequest
+	9,  // 18: picker.v1.PickerService.GetStats:output_type -> picker.v1.GetStatsResponse
+	11, // 19: picker.v1.PickerService.ListHardware:output_type -> picker.v1.ListHardwareResponse
+	13, // 20: picker.v1.PickerService.WatchSensors:output_type -> picker.v1.WatchSensorsResponse
+	18, // [18:21] is the sub-list for method output_type
+	15, // [15:18] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_picker_v1_picker_proto_init() }
+func file_picker_v1_picker_proto_init() {
+	if File_picker_v1_picker_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_picker_v1_picker_proto_rawDesc), len(file_picker_v1_picker_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_picker_v1_picker_proto_goTypes,
+		DependencyIndexes: file_picker_v1_picker_proto_depIdxs,
+		EnumInfos:         file_picker_v1_picker_proto_enumTypes,
+		MessageInfos:      file_picker_v1_picker_proto_msgTypes,
+	}.Build()
+	File_picker_v1_picker_proto = out.File
+	file_picker_v1_picker_proto_goTypes = nil
+	file_picker_v1_picker_proto_depIdxs = nil
+}
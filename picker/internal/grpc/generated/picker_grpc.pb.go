@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: picker/v1/picker.proto
+
+package pickerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PickerService_GetStats_FullMethodName     = "/picker.v1.PickerService/GetStats"
+	PickerService_ListHardware_FullMethodName = "/picker.v1.PickerService/ListHardware"
+	PickerService_WatchSensors_FullMethodName = "/picker.v1.PickerService/WatchSensors"
+)
+
+// PickerServiceClient is the client API for PickerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PickerService serves the hardware stats, it mirrors the HTTP API.
+type PickerServiceClient interface {
+	// GetStats returns the hardware with its sensors.
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+	// ListHardware returns the hardware without its sensors.
+	ListHardware(ctx context.Context, in *ListHardwareRequest, opts ...grpc.CallOption) (*ListHardwareResponse, error)
+	// WatchSensors streams the stats, all the streams share a single sampling loop.
+	WatchSensors(ctx context.Context, in *WatchSensorsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchSensorsResponse], error)
+}
+
+type pickerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPickerServiceClient(cc grpc.ClientConnInterface) PickerServiceClient {
+	return &pickerServiceClient{cc}
+}
+
+func (c *pickerServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, PickerService_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickerServiceClient) ListHardware(ctx context.Context, in *ListHardwareRequest, opts ...grpc.CallOption) (*ListHardwareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHardwareResponse)
+	err := c.cc.Invoke(ctx, PickerService_ListHardware_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickerServiceClient) WatchSensors(ctx context.Context, in *WatchSensorsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchSensorsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PickerService_ServiceDesc.Streams[0], PickerService_WatchSensors_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSensorsRequest, WatchSensorsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PickerService_WatchSensorsClient = grpc.ServerStreamingClient[WatchSensorsResponse]
+
+// PickerServiceServer is the server API for PickerService service.
+// All implementations must embed UnimplementedPickerServiceServer
+// for forward compatibility.
+//
+// PickerService serves the hardware stats, it mirrors the HTTP API.
+type PickerServiceServer interface {
+	// GetStats returns the hardware with its sensors.
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	// ListHardware returns the hardware without its sensors.
+	ListHardware(context.Context, *ListHardwareRequest) (*ListHardwareResponse, error)
+	// WatchSensors streams the stats, all the streams share a single sampling loop.
+	WatchSensors(*WatchSensorsRequest, grpc.ServerStreamingServer[WatchSensorsResponse]) error
+	mustEmbedUnimplementedPickerServiceServer()
+}
+
+// UnimplementedPickerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPickerServiceServer struct{}
+
+func (UnimplementedPickerServiceServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedPickerServiceServer) ListHardware(context.Context, *ListHardwareRequest) (*ListHardwareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHardware not implemented")
+}
+func (UnimplementedPickerServiceServer) WatchSensors(*WatchSensorsRequest, grpc.ServerStreamingServer[WatchSensorsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSensors not implemented")
+}
+func (UnimplementedPickerServiceServer) mustEmbedUnimplementedPickerServiceServer() {}
+func (UnimplementedPickerServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafePickerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PickerServiceServer will
+// result in compilation errors.
+type UnsafePickerServiceServer interface {
+	mustEmbedUnimplementedPickerServiceServer()
+}
+
+func RegisterPickerServiceServer(s grpc.ServiceRegistrar, srv PickerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPickerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PickerService_ServiceDesc, srv)
+}
+
+func _PickerService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickerServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickerService_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickerServiceServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickerService_ListHardware_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHardwareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickerServiceServer).ListHardware(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickerService_ListHardware_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickerServiceServer).ListHardware(ctx, req.(*ListHardwareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickerService_WatchSensors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSensorsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PickerServiceServer).WatchSensors(m, &grpc.GenericServerStream[WatchSensorsRequest, WatchSensorsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PickerService_WatchSensorsServer = grpc.ServerStreamingServer[WatchSensorsResponse]
+
+// PickerService_ServiceDesc is the grpc.ServiceDesc for PickerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PickerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "picker.v1.PickerService",
+	HandlerType: (*PickerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStats",
+			Handler:    _PickerService_GetStats_Handler,
+		},
+		{
+			MethodName: "ListHardware",
+			Handler:    _PickerService_ListHardware_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSensors",
+			Handler:       _PickerService_WatchSensors_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "picker/v1/picker.proto",
+}
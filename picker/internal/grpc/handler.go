@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	pickerv1 "github.com/genvmoroz/win-stats/picker/internal/grpc/generated"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Service interface {
+	GetStats(ctx context.Context, req core.GetStatsRequest) (core.GetStatsResponse, error)
+	GetHardware(ctx context.Context) ([]core.Hardware, error)
+	StreamStats(ctx context.Context, req core.StreamStatsRequest) (<-chan core.StatsEvent, error)
+}
+
+type Handler struct {
+	pickerv1.UnimplementedPickerServiceServer
+
+	srv         Service
+	transformer Transformer
+}
+
+func NewHandler(srv Service) (*Handler, error) {
+	if lo.IsNil(srv) {
+		return nil, errors.New("service is nil")
+	}
+	return &Handler{
+		srv:         srv,
+		transformer: Transformer{},
+	}, nil
+}
+
+var _ pickerv1.PickerServiceServer = (*Handler)(nil)
+
+func (h *Handler) GetStats(ctx context.Context, req *pickerv1.GetStatsRequest) (*pickerv1.GetStatsResponse, error) {
+	statsReq, err := h.transformer.GetStatsRequestToCore(req.GetFilter(), req.GetUnits())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stats, err := h.srv.GetStats(ctx, statsReq)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pickerv1.GetStatsResponse{
+		Hardware: h.transformer.HardwareListFromCore(stats.Stats),
+		Warnings: stats.Warnings,
+	}, nil
+}
+
+func (h *Handler) ListHardware(ctx context.Context, _ *pickerv1.ListHardwareRequest) (*pickerv1.ListHardwareResponse, error) {
+	hardware, err := h.srv.GetHardware(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pickerv1.ListHardwareResponse{
+		Hardware: lo.Map(hardware, func(hw core.Hardware, _ int) *pickerv1.Hardware {
+			return h.transformer.HardwareFromCore(hw, nil)
+		}),
+	}, nil
+}
+
+func (h *Handler) WatchSensors(req *pickerv1.WatchSensorsRequest, stream grpc.ServerStreamingServer[pickerv1.WatchSensorsResponse]) error {
+	statsReq, err := h.transformer.GetStatsRequestToCore(req.GetFilter(), req.GetUnits())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	interval := time.Second
+	if req.GetInterval() != nil {
+		interval = req.GetInterval().AsDuration()
+	}
+
+	events, err := h.srv.StreamStats(stream.Context(), core.StreamStatsRequest{
+		GetStatsRequest: statsReq,
+		Interval:        interval,
+		ChangesOnly:     req.GetChangesOnly(),
+		LastEventID:     req.GetLastEventId(),
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for event := range events {
+		if err = stream.Send(h.transformer.WatchSensorsResponseFromCore(event)); err != nil {
+			return fmt.Errorf("send event %s: %w", event.ID, err)
+		}
+	}
+
+	return nil
+}
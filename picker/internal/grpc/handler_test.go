@@ -0,0 +1,195 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	pickergrpc "github.com/genvmoroz/win-stats/picker/internal/grpc"
+	pickerv1 "github.com/genvmoroz/win-stats/picker/internal/grpc/generated"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	cpu     = core.Hardware{ID: "/intelcpu/0", Name: "Intel Core i7", Type: core.CPU}
+	board   = core.Hardware{ID: "/motherboard", Name: "Board", Type: core.Motherboard}
+	cpuTemp = core.Sensor{
+		ID:         "/intelcpu/0/temperature/0",
+		HardwareID: cpu.ID,
+		Name:       "CPU Package",
+		Type:       core.Temperature,
+		Unit:       core.Celsius,
+		Value:      core.SensorValue{Value: 42.5, Min: 30, Max: 90, Timestamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+	}
+)
+
+func TestHandlerGetStats(t *testing.T) {
+	t.Parallel()
+
+	srv := &fakeService{stats: map[core.Hardware][]core.Sensor{cpu: {cpuTemp}, board: nil}}
+	client := newClient(t, srv)
+
+	resp, err := client.GetStats(context.Background(), &pickerv1.GetStatsRequest{
+		Filter: &pickerv1.StatsFilter{
+			HardwareTypes: []pickerv1.HardwareType{pickerv1.HardwareType_HARDWARE_TYPE_CPU},
+			NamePatterns:  []string{"cpu*"},
+		},
+		Units: []string{"fahrenheit"},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t,
+		core.GetStatsRequest{
+			Filter: core.StatsFilter{
+				HardwareTypes: []core.HardwareType{core.CPU},
+				HardwareIDs:   []core.HardwareID{},
+				SensorTypes:   []core.SensorType{},
+				SensorIDs:     []core.SensorID{},
+				NamePatterns:  []string{"cpu*"},
+			},
+			Units: core.UnitPreferences{Temperature: core.Fahrenheit},
+		},
+		srv.statsReq,
+	)
+
+	// the hardware without sensors is skipped the same way as by the flat /v2/stats
+	require.Len(t, resp.GetHardware(), 1)
+	hw := resp.GetHardware()[0]
+	require.Equal(t, "/intelcpu/0", hw.GetId())
+	require.Equal(t, pickerv1.HardwareType_HARDWARE_TYPE_CPU, hw.GetType())
+	require.Len(t, hw.GetSensors(), 1)
+	sensor := hw.GetSensors()[0]
+	require.Equal(t, pickerv1.SensorType_SENSOR_TYPE_TEMPERATURE, sensor.GetType())
+	require.Equal(t, pickerv1.Unit_UNIT_CELSIUS, sensor.GetUnit())
+	require.InDelta(t, 42.5, sensor.GetValue().GetValue(), 0)
+	require.Equal(t, cpuTemp.Value.Timestamp, sensor.GetValue().GetTimestamp().AsTime())
+}
+
+func TestHandlerGetStatsInvalidArgument(t *testing.T) {
+	t.Parallel()
+
+	client := newClient(t, &fakeService{})
+
+	_, err := client.GetStats(context.Background(), &pickerv1.GetStatsRequest{
+		Filter: &pickerv1.StatsFilter{
+			SensorTypes: []pickerv1.SensorType{pickerv1.SensorType_SENSOR_TYPE_UNSPECIFIED},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetStats(context.Background(), &pickerv1.GetStatsRequest{Units: []string{"kelvin"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestHandlerListHardware(t *testing.T) {
+	t.Parallel()
+
+	client := newClient(t, &fakeService{hardware: []core.Hardware{cpu, board}})
+
+	resp, err := client.ListHardware(context.Background(), &pickerv1.ListHardwareRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetHardware(), 2)
+	require.Equal(t, "/motherboard", resp.GetHardware()[1].GetId())
+	require.Equal(t, pickerv1.HardwareType_HARDWARE_TYPE_MOTHERBOARD, resp.GetHardware()[1].GetType())
+}
+
+func TestHandlerWatchSensors(t *testing.T) {
+	t.Parallel()
+
+	srv := &fakeService{
+		events: []core.StatsEvent{
+			{ID: "1-1", Kind: core.SnapshotEvent, Stats: map[core.Hardware][]core.Sensor{cpu: {cpuTemp}}},
+			{ID: "1-2", Kind: core.ErrorEvent, Err: context.DeadlineExceeded},
+		},
+	}
+	client := newClient(t, srv)
+
+	stream, err := client.WatchSensors(context.Background(), &pickerv1.WatchSensorsRequest{
+		Interval:    durationpb.New(5 * time.Second),
+		ChangesOnly: true,
+		LastEventId: "1-0",
+	})
+	require.NoError(t, err)
+
+	first, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "1-1", first.GetEventId())
+	require.Equal(t, pickerv1.WatchEventKind_WATCH_EVENT_KIND_SNAPSHOT, first.GetKind())
+	require.Len(t, first.GetHardware(), 1)
+
+	second, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, pickerv1.WatchEventKind_WATCH_EVENT_KIND_ERROR, second.GetKind())
+	require.Equal(t, context.DeadlineExceeded.Error(), second.GetError())
+
+	require.Equal(t, 5*time.Second, srv.streamReq.Interval)
+	require.True(t, srv.streamReq.ChangesOnly)
+	require.Equal(t, "1-0", srv.streamReq.LastEventID)
+}
+
+func newClient(t *testing.T, srv pickergrpc.Service) pickerv1.PickerServiceClient {
+	t.Helper()
+
+	handler, err := pickergrpc.NewHandler(srv)
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	pickerv1.RegisterPickerServiceServer(server, handler)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return pickerv1.NewPickerServiceClient(conn)
+}
+
+// fakeService records the requests, it is used by a single call at a time.
+type fakeService struct {
+	stats    map[core.Hardware][]core.Sensor
+	hardware []core.Hardware
+	events   []core.StatsEvent
+
+	statsReq  core.GetStatsRequest
+	streamReq core.StreamStatsRequest
+}
+
+func (s *fakeService) GetStats(_ context.Context, req core.GetStatsRequest) (core.GetStatsResponse, error) {
+	s.statsReq = req
+	return core.GetStatsResponse{Stats: s.stats}, nil
+}
+
+func (s *fakeService) GetHardware(_ context.Context) ([]core.Hardware, error) {
+	return s.hardware, nil
+}
+
+func (s *fakeService) StreamStats(_ context.Context, req core.StreamStatsRequest) (<-chan core.StatsEvent, error) {
+	s.streamReq = req
+
+	events := make(chan core.StatsEvent, len(s.events))
+	for _, event := range s.events {
+		events <- event
+	}
+	close(events)
+
+	return events, nil
+}
@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/genvmoroz/win-stats/picker/internal/auth"
+	pickerv1 "github.com/genvmoroz/win-stats/picker/internal/grpc/generated"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	Config struct {
+		Port uint `envconfig:"APP_GRPC_SERVER_PORT" default:"9090"`
+	}
+
+	// Security is the one of the HTTP server, so the gRPC port is not a way around it.
+	Security struct {
+		// TLS turns TLS on if it is set, the client certificates are required if it has the client CAs.
+		TLS *tls.Config
+		// AuthTokens are the bearer tokens accepted in the authorization metadata, any of them grants the access
+		// to all the methods except the health check. The auth is off if there are none.
+		AuthTokens []string
+	}
+
+	Server struct {
+		cfg      Config
+		security Security
+		grpc     *grpc.Server
+		logger   logrus.FieldLogger
+	}
+)
+
+func NewServer(ctx context.Context, cfg Config, security Security, handler *Handler, logger logrus.FieldLogger) (*Server, error) {
+	if lo.IsNil(handler) {
+		return nil, fmt.Errorf("handler is nil")
+	}
+	if lo.IsNil(logger) {
+		return nil, fmt.Errorf("logger is nil")
+	}
+	l := net.ListenConfig{}
+	ln, err := l.Listen(ctx, "tcp", fmt.Sprintf(":%d", cfg.Port))
+	if err != nil {
+		return nil, fmt.Errorf("can't listen on port %d: %w", cfg.Port, err)
+	}
+
+	if err = ln.Close(); err != nil {
+		return nil, fmt.Errorf("can't close listener: %w", err)
+	}
+
+	server := &Server{
+		cfg:      cfg,
+		security: security,
+		logger:   logger,
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(server.authUnary),
+		grpc.ChainStreamInterceptor(server.authStream),
+	}
+	if security.TLS != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(security.TLS)))
+	}
+	server.grpc = grpc.NewServer(opts...)
+
+	pickerv1.RegisterPickerServiceServer(server.grpc, handler)
+	healthpb.RegisterHealthServer(server.grpc, health.NewServer())
+	// lets grpcurl and similar tools discover the service without the proto files
+	reflection.Register(server.grpc)
+
+	return server, nil
+}
+
+// Run starts the server and listens for incoming requests.
+// The server will be stopped when the context is canceled, the open streams are canceled as well.
+func (s *Server) Run(ctx context.Context) error {
+	l := net.ListenConfig{}
+	ln, err := l.Listen(ctx, "tcp", fmt.Sprintf(":%d", s.cfg.Port))
+	if err != nil {
+		return fmt.Errorf("listen on port %d: %w", s.cfg.Port, err)
+	}
+
+	errChan := make(chan error, 1)
+	go func(ch chan error) {
+		s.logger.Debug("starting grpc server")
+		ch <- s.grpc.Serve(ln)
+	}(errChan)
+
+	select {
+	case <-ctx.Done():
+	case err = <-errChan:
+		return err
+	}
+
+	// the streams last until the client cancels them, so they are not waited for
+	s.grpc.Stop()
+	s.logger.Debug("grpc server stopped")
+
+	return nil
+}
+
+func (s *Server) authUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := s.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (s *Server) authStream(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.authorize(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, stream)
+}
+
+// authorize checks the bearer token the same way the HTTP server does, the health check is open to the probes.
+func (s *Server) authorize(ctx context.Context, method string) error {
+	if len(s.security.AuthTokens) == 0 || strings.HasPrefix(method, "/"+healthpb.Health_ServiceDesc.ServiceName+"/") {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		if token, ok := strings.CutPrefix(value, "Bearer "); ok && auth.ValidToken(token, s.security.AuthTokens) {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "missing or invalid bearer token")
+}
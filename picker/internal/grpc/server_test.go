@@ -0,0 +1,166 @@
+package grpc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	pickergrpc "github.com/genvmoroz/win-stats/picker/internal/grpc"
+	pickerv1 "github.com/genvmoroz/win-stats/picker/internal/grpc/generated"
+	"github.com/genvmoroz/win-stats/picker/internal/testutils"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestServerAuth(t *testing.T) {
+	t.Parallel()
+
+	addr := runServer(t, pickergrpc.Security{AuthTokens: []string{"old", "new"}})
+	conn := dial(t, addr, insecure.NewCredentials())
+	client := pickerv1.NewPickerServiceClient(conn)
+
+	tests := map[string]struct {
+		header   string
+		wantCode codes.Code
+	}{
+		"no token":      {wantCode: codes.Unauthenticated},
+		"wrong token":   {header: "Bearer wrong", wantCode: codes.Unauthenticated},
+		"wrong scheme":  {header: "Basic new", wantCode: codes.Unauthenticated},
+		"token":         {header: "Bearer new", wantCode: codes.OK},
+		"rotated token": {header: "Bearer old", wantCode: codes.OK},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if test.header != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", test.header)
+			}
+
+			_, err := client.ListHardware(ctx, &pickerv1.ListHardwareRequest{})
+			require.Equal(t, test.wantCode, status.Code(err), "unary")
+
+			stream, err := client.WatchSensors(ctx, &pickerv1.WatchSensorsRequest{})
+			require.NoError(t, err)
+			_, err = stream.Recv()
+			if test.wantCode == codes.OK {
+				// the service has no events, so the authorized stream just ends
+				require.NotEqual(t, codes.Unauthenticated, status.Code(err), "stream")
+				return
+			}
+			require.Equal(t, test.wantCode, status.Code(err), "stream")
+		})
+	}
+
+	t.Run("health is open", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+	})
+}
+
+func TestServerTLS(t *testing.T) {
+	t.Parallel()
+
+	cert, roots := selfSignedCert(t)
+	addr := runServer(t, pickergrpc.Security{
+		TLS: &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12},
+	})
+
+	secure := pickerv1.NewPickerServiceClient(dial(t, addr, credentials.NewTLS(&tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12})))
+	_, err := secure.ListHardware(context.Background(), &pickerv1.ListHardwareRequest{})
+	require.NoError(t, err)
+
+	plain := pickerv1.NewPickerServiceClient(dial(t, addr, insecure.NewCredentials()))
+	_, err = plain.ListHardware(context.Background(), &pickerv1.ListHardwareRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+// runServer starts the server and returns its address, the server is stopped by the test cleanup.
+func runServer(t *testing.T, security pickergrpc.Security) string {
+	t.Helper()
+
+	handler, err := pickergrpc.NewHandler(&fakeService{})
+	require.NoError(t, err)
+
+	port := testutils.FreePort(t)
+	server, err := pickergrpc.NewServer(context.Background(), pickergrpc.Config{Port: port}, security, handler, logrus.New())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- server.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	addr := fmt.Sprintf("localhost:%d", port)
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			return false
+		}
+		_ = conn.Close()
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return addr
+}
+
+func dial(t *testing.T, addr string, creds credentials.TransportCredentials) *grpc.ClientConn {
+	t.Helper()
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+// selfSignedCert issues the certificate of localhost, the pool trusts it.
+func selfSignedCert(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(leaf)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, roots
+}
@@ -0,0 +1,342 @@
+package grpc
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	pickerv1 "github.com/genvmoroz/win-stats/picker/internal/grpc/generated"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Transformer struct{}
+
+// GetStatsRequestToCore parses the filter and the unit preferences, the unspecified types are rejected.
+func (t Transformer) GetStatsRequestToCore(filter *pickerv1.StatsFilter, units []string) (core.GetStatsRequest, error) {
+	var errs []error
+
+	hardwareTypes := lo.Map(filter.GetHardwareTypes(), func(in pickerv1.HardwareType, _ int) core.HardwareType {
+		out, err := hardwareTypeToCore(in)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return out
+	})
+	sensorTypes := lo.Map(filter.GetSensorTypes(), func(in pickerv1.SensorType, _ int) core.SensorType {
+		out, err := sensorTypeToCore(in)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return out
+	})
+	prefs, err := core.ParseUnitPreferences(units)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return core.GetStatsRequest{}, errors.Join(errs...)
+	}
+
+	return core.GetStatsRequest{
+		Filter: core.StatsFilter{
+			HardwareTypes: hardwareTypes,
+			SensorTypes:   sensorTypes,
+			HardwareIDs: lo.Map(filter.GetHardwareIds(), func(id string, _ int) core.HardwareID {
+				return core.HardwareID(id)
+			}),
+			SensorIDs: lo.Map(filter.GetSensorIds(), func(id string, _ int) core.SensorID {
+				return core.SensorID(id)
+			}),
+			NamePatterns: filter.GetNamePatterns(),
+		},
+		Units: prefs,
+	}, nil
+}
+
+// HardwareListFromCore returns the hardware with sensors sorted by ID, the same way as the flat /v2/stats does.
+func (t Transformer) HardwareListFromCore(in map[core.Hardware][]core.Sensor) []*pickerv1.Hardware {
+	hardware := lo.Filter(lo.Keys(in), func(hw core.Hardware, _ int) bool {
+		return len(in[hw]) > 0
+	})
+	slices.SortFunc(hardware, func(a, b core.Hardware) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return lo.Map(hardware, func(hw core.Hardware, _ int) *pickerv1.Hardware {
+		return t.HardwareFromCore(hw, in[hw])
+	})
+}
+
+func (t Transformer) HardwareFromCore(hw core.Hardware, sensors []core.Sensor) *pickerv1.Hardware {
+	return &pickerv1.Hardware{
+		Id:       string(hw.ID),
+		ParentId: string(hw.ParentID),
+		Name:     hw.Name,
+		Type:     hardwareTypeFromCore(hw.Type),
+		RawType:  hw.RawType,
+		Subtype:  hw.Subtype,
+		Sensors: lo.Map(sensors, func(s core.Sensor, _ int) *pickerv1.Sensor {
+			return t.SensorFromCore(s)
+		}),
+	}
+}
+
+func (t Transformer) SensorFromCore(in core.Sensor) *pickerv1.Sensor {
+	return &pickerv1.Sensor{
+		Id:         string(in.ID),
+		HardwareId: string(in.HardwareID),
+		Name:       in.Name,
+		Type:       sensorTypeFromCore(in.Type),
+		RawType:    in.RawType,
+		Index:      int32(in.Index), //nolint:gosec // the index is the position among a handful of sensors
+		Unit:       unitFromCore(in.Unit),
+		Value: &pickerv1.SensorValue{
+			Value:     in.Value.Value,
+			Min:       in.Value.Min,
+			Max:       in.Value.Max,
+			Timestamp: timestamppb.New(in.Value.Timestamp),
+		},
+	}
+}
+
+func (t Transformer) WatchSensorsResponseFromCore(in core.StatsEvent) *pickerv1.WatchSensorsResponse {
+	out := &pickerv1.WatchSensorsResponse{
+		EventId:  in.ID,
+		Hardware: t.HardwareListFromCore(in.Stats),
+		Warnings: in.Warnings,
+	}
+
+	switch in.Kind {
+	case core.SnapshotEvent:
+		out.Kind = pickerv1.WatchEventKind_WATCH_EVENT_KIND_SNAPSHOT
+	case core.ChangesEvent:
+		out.Kind = pickerv1.WatchEventKind_WATCH_EVENT_KIND_CHANGES
+	case core.ErrorEvent:
+		out.Kind = pickerv1.WatchEventKind_WATCH_EVENT_KIND_ERROR
+		out.Error = in.Err.Error()
+	}
+
+	return out
+}
+
+func hardwareTypeToCore(in pickerv1.HardwareType) (core.HardwareType, error) {
+	switch in {
+	case pickerv1.HardwareType_HARDWARE_TYPE_SUPER_IO:
+		return core.SuperIO, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_CPU:
+		return core.CPU, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_GPU:
+		return core.GPU, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_T_BALANCER:
+		return core.TBalancer, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_HEAT_MASTER:
+		return core.HeatMaster, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_HDD:
+		return core.HDD, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_RAM:
+		return core.RAM, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_NETWORK:
+		return core.Network, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_MEMORY:
+		return core.Memory, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_STORAGE:
+		return core.Storage, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_MOTHERBOARD:
+		return core.Motherboard, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_BATTERY:
+		return core.Battery, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_COOLER:
+		return core.Cooler, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_EMBEDDED_CONTROLLER:
+		return core.EmbeddedController, nil
+	case pickerv1.HardwareType_HARDWARE_TYPE_PSU:
+		return core.PSU, nil
+	default:
+		return core.UnknownHardwareType, fmt.Errorf("unknown hardware type: %s", in)
+	}
+}
+
+func hardwareTypeFromCore(in core.HardwareType) pickerv1.HardwareType {
+	switch in {
+	case core.SuperIO:
+		return pickerv1.HardwareType_HARDWARE_TYPE_SUPER_IO
+	case core.CPU:
+		return pickerv1.HardwareType_HARDWARE_TYPE_CPU
+	case core.GPU:
+		return pickerv1.HardwareType_HARDWARE_TYPE_GPU
+	case core.TBalancer:
+		return pickerv1.HardwareType_HARDWARE_TYPE_T_BALANCER
+	case core.HeatMaster:
+		return pickerv1.HardwareType_HARDWARE_TYPE_HEAT_MASTER
+	case core.HDD:
+		return pickerv1.HardwareType_HARDWARE_TYPE_HDD
+	case core.RAM:
+		return pickerv1.HardwareType_HARDWARE_TYPE_RAM
+	case core.Network:
+		return pickerv1.HardwareType_HARDWARE_TYPE_NETWORK
+	case core.Memory:
+		return pickerv1.HardwareType_HARDWARE_TYPE_MEMORY
+	case core.Storage:
+		return pickerv1.HardwareType_HARDWARE_TYPE_STORAGE
+	case core.Motherboard:
+		return pickerv1.HardwareType_HARDWARE_TYPE_MOTHERBOARD
+	case core.Battery:
+		return pickerv1.HardwareType_HARDWARE_TYPE_BATTERY
+	case core.Cooler:
+		return pickerv1.HardwareType_HARDWARE_TYPE_COOLER
+	case core.EmbeddedController:
+		return pickerv1.HardwareType_HARDWARE_TYPE_EMBEDDED_CONTROLLER
+	case core.PSU:
+		return pickerv1.HardwareType_HARDWARE_TYPE_PSU
+	default:
+		return pickerv1.HardwareType_HARDWARE_TYPE_UNSPECIFIED
+	}
+}
+
+func sensorTypeToCore(in pickerv1.SensorType) (core.SensorType, error) {
+	switch in {
+	case pickerv1.SensorType_SENSOR_TYPE_VOLTAGE:
+		return core.Voltage, nil
+	case pickerv1.SensorType_SENSOR_TYPE_CLOCK:
+		return core.Clock, nil
+	case pickerv1.SensorType_SENSOR_TYPE_TEMPERATURE:
+		return core.Temperature, nil
+	case pickerv1.SensorType_SENSOR_TYPE_LOAD:
+		return core.Load, nil
+	case pickerv1.SensorType_SENSOR_TYPE_FAN:
+		return core.Fan, nil
+	case pickerv1.SensorType_SENSOR_TYPE_FLOW:
+		return core.Flow, nil
+	case pickerv1.SensorType_SENSOR_TYPE_CONTROL:
+		return core.Control, nil
+	case pickerv1.SensorType_SENSOR_TYPE_LEVEL:
+		return core.Level, nil
+	case pickerv1.SensorType_SENSOR_TYPE_POWER:
+		return core.Power, nil
+	case pickerv1.SensorType_SENSOR_TYPE_SMALL_DATA:
+		return core.SmallData, nil
+	case pickerv1.SensorType_SENSOR_TYPE_THROUGHPUT:
+		return core.Throughput, nil
+	case pickerv1.SensorType_SENSOR_TYPE_DATA:
+		return core.Data, nil
+	case pickerv1.SensorType_SENSOR_TYPE_FACTOR:
+		return core.Factor, nil
+	case pickerv1.SensorType_SENSOR_TYPE_ENERGY:
+		return core.Energy, nil
+	case pickerv1.SensorType_SENSOR_TYPE_CURRENT:
+		return core.Current, nil
+	case pickerv1.SensorType_SENSOR_TYPE_FREQUENCY:
+		return core.Frequency, nil
+	case pickerv1.SensorType_SENSOR_TYPE_TIME_SPAN:
+		return core.TimeSpan, nil
+	case pickerv1.SensorType_SENSOR_TYPE_NOISE:
+		return core.Noise, nil
+	case pickerv1.SensorType_SENSOR_TYPE_CONDUCTIVITY:
+		return core.Conductivity, nil
+	case pickerv1.SensorType_SENSOR_TYPE_HUMIDITY:
+		return core.Humidity, nil
+	default:
+		return core.UnknownSensorType, fmt.Errorf("unknown sensor type: %s", in)
+	}
+}
+
+func sensorTypeFromCore(in core.SensorType) pickerv1.SensorType {
+	switch in {
+	case core.Voltage:
+		return pickerv1.SensorType_SENSOR_TYPE_VOLTAGE
+	case core.Clock:
+		return pickerv1.SensorType_SENSOR_TYPE_CLOCK
+	case core.Temperature:
+		return pickerv1.SensorType_SENSOR_TYPE_TEMPERATURE
+	case core.Load:
+		return pickerv1.SensorType_SENSOR_TYPE_LOAD
+	case core.Fan:
+		return pickerv1.SensorType_SENSOR_TYPE_FAN
+	case core.Flow:
+		return pickerv1.SensorType_SENSOR_TYPE_FLOW
+	case core.Control:
+		return pickerv1.SensorType_SENSOR_TYPE_CONTROL
+	case core.Level:
+		return pickerv1.SensorType_SENSOR_TYPE_LEVEL
+	case core.Power:
+		return pickerv1.SensorType_SENSOR_TYPE_POWER
+	case core.SmallData:
+		return pickerv1.SensorType_SENSOR_TYPE_SMALL_DATA
+	case core.Throughput:
+		return pickerv1.SensorType_SENSOR_TYPE_THROUGHPUT
+	case core.Data:
+		return pickerv1.SensorType_SENSOR_TYPE_DATA
+	case core.Factor:
+		return pickerv1.SensorType_SENSOR_TYPE_FACTOR
+	case core.Energy:
+		return pickerv1.SensorType_SENSOR_TYPE_ENERGY
+	case core.Current:
+		return pickerv1.SensorType_SENSOR_TYPE_CURRENT
+	case core.Frequency:
+		return pickerv1.SensorType_SENSOR_TYPE_FREQUENCY
+	case core.TimeSpan:
+		return pickerv1.SensorType_SENSOR_TYPE_TIME_SPAN
+	case core.Noise:
+		return pickerv1.SensorType_SENSOR_TYPE_NOISE
+	case core.Conductivity:
+		return pickerv1.SensorType_SENSOR_TYPE_CONDUCTIVITY
+	case core.Humidity:
+		return pickerv1.SensorType_SENSOR_TYPE_HUMIDITY
+	default:
+		return pickerv1.SensorType_SENSOR_TYPE_UNSPECIFIED
+	}
+}
+
+func unitFromCore(in core.Unit) pickerv1.Unit {
+	switch in {
+	case core.Volt:
+		return pickerv1.Unit_UNIT_VOLT
+	case core.Megahertz:
+		return pickerv1.Unit_UNIT_MEGAHERTZ
+	case core.Celsius:
+		return pickerv1.Unit_UNIT_CELSIUS
+	case core.Percentage:
+		return pickerv1.Unit_UNIT_PERCENTAGE
+	case core.RevolutionsPerMinute:
+		return pickerv1.Unit_UNIT_REVOLUTIONS_PER_MINUTE
+	case core.LitersPerHour:
+		return pickerv1.Unit_UNIT_LITERS_PER_HOUR
+	case core.Watts:
+		return pickerv1.Unit_UNIT_WATTS
+	case core.Gigabytes:
+		return pickerv1.Unit_UNIT_GIGABYTES
+	case core.Megabytes:
+		return pickerv1.Unit_UNIT_MEGABYTES
+	case core.KilobytesPerSecond:
+		return pickerv1.Unit_UNIT_KILOBYTES_PER_SECOND
+	case core.Amperes:
+		return pickerv1.Unit_UNIT_AMPERES
+	case core.MilliwattHours:
+		return pickerv1.Unit_UNIT_MILLIWATT_HOURS
+	case core.BytesPerSecond:
+		return pickerv1.Unit_UNIT_BYTES_PER_SECOND
+	case core.MegabytesPerSecond:
+		return pickerv1.Unit_UNIT_MEGABYTES_PER_SECOND
+	case core.Terabytes:
+		return pickerv1.Unit_UNIT_TERABYTES
+	case core.Fahrenheit:
+		return pickerv1.Unit_UNIT_FAHRENHEIT
+	case core.Gigahertz:
+		return pickerv1.Unit_UNIT_GIGAHERTZ
+	case core.Ratio:
+		return pickerv1.Unit_UNIT_RATIO
+	case core.Hertz:
+		return pickerv1.Unit_UNIT_HERTZ
+	case core.Seconds:
+		return pickerv1.Unit_UNIT_SECONDS
+	case core.Decibels:
+		return pickerv1.Unit_UNIT_DECIBELS
+	case core.MicrosiemensPerCentimeter:
+		return pickerv1.Unit_UNIT_MICROSIEMENS_PER_CENTIMETER
+	default:
+		return pickerv1.Unit_UNIT_UNSPECIFIED
+	}
+}
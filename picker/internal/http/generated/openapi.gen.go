@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -17,9 +18,33 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 )
 
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Defines values for HealthStatus.
+const (
+	CRIT HealthStatus = "CRIT"
+	OK   HealthStatus = "OK"
+	WARN HealthStatus = "WARN"
+)
+
+// Defines values for StreamStatsParamsMode.
+const (
+	Changes  StreamStatsParamsMode = "changes"
+	Snapshot StreamStatsParamsMode = "snapshot"
+)
+
+// Defines values for GetStatsV2ParamsLayout.
+const (
+	Flat GetStatsV2ParamsLayout = "flat"
+	Tree GetStatsV2ParamsLayout = "tree"
+)
+
 // Error Describes an error response
 type Error struct {
 	Message    *string `json:"Message,omitempty"`
@@ -31,132 +56,1046 @@ type Hardware struct {
 	ID      *string   `json:"ID,omitempty"`
 	Name    *string   `json:"Name,omitempty"`
 	Sensors *[]Sensor `json:"Sensors,omitempty"`
-	Type    *string   `json:"Type,omitempty"`
+
+	// Subtype Type reported by the sensor backend, e.g. GpuNvidia, tells apart the hardware of the same Type
+	Subtype *string `json:"Subtype,omitempty"`
+
+	// Type One of Motherboard, SuperIO, CPU, GPU, Memory, RAM, Storage, HDD, Network, Cooler, EmbeddedController,
+	// PSU, Battery, TBalancer, HeatMaster or UnknownHardwareType
+	Type *string `json:"Type,omitempty"`
+}
+
+// HardwareStatus Describes the health of a hardware component
+type HardwareStatus struct {
+	ID   *string `json:"ID,omitempty"`
+	Name *string `json:"Name,omitempty"`
+
+	// Sensors The sensors whose status is not OK
+	Sensors *[]SensorStatus `json:"Sensors,omitempty"`
+
+	// Status Health judged by the sensor thresholds, the sensor without a matching threshold is OK
+	Status *HealthStatus `json:"Status,omitempty"`
+	Type   *string       `json:"Type,omitempty"`
+}
+
+// HardwareV2 Describes a hardware component
+type HardwareV2 struct {
+	// Children The nested hardware, returned only for the tree layout
+	Children *[]HardwareV2 `json:"Children,omitempty"`
+	ID       *string       `json:"ID,omitempty"`
+	Name     *string       `json:"Name,omitempty"`
+
+	// ParentID ID of the hardware this one is attached to, absent for the top-level hardware
+	ParentID *string `json:"ParentID,omitempty"`
+
+	// RawType Type reported by the sensor backend, present only if the Type is Unknown
+	RawType *string     `json:"RawType,omitempty"`
+	Sensors *[]SensorV2 `json:"Sensors,omitempty"`
+
+	// Subtype Type reported by the sensor backend, e.g. GpuNvidia, tells apart the hardware of the same Type
+	Subtype *string `json:"Subtype,omitempty"`
+
+	// Type One of Motherboard, SuperIO, CPU, GPU, Memory, RAM, Storage, HDD, Network, Cooler, EmbeddedController,
+	// PSU, Battery, TBalancer, HeatMaster or UnknownHardwareType
+	Type *string `json:"Type,omitempty"`
+}
+
+// HealthStatus Health judged by the sensor thresholds, the sensor without a matching threshold is OK
+type HealthStatus string
+
+// HostStatus Describes a response to the GetStatus endpoint
+type HostStatus struct {
+	Hardware *[]HardwareStatus `json:"Hardware,omitempty"`
+
+	// Status Health judged by the sensor thresholds, the sensor without a matching threshold is OK
+	Status *HealthStatus `json:"Status,omitempty"`
+}
+
+// Sensor Describes a sensor
+type Sensor struct {
+	ID *string `json:"ID,omitempty"`
+
+	// Index Position of the sensor among the sensors of the same type of the hardware
+	Index *int    `json:"Index,omitempty"`
+	Name  *string `json:"Name,omitempty"`
+
+	// RawType Type reported by the sensor backend, present only if the Type is Unknown
+	RawType *string `json:"RawType,omitempty"`
+
+	// Status Health judged by the sensor thresholds, the sensor without a matching threshold is OK
+	Status *HealthStatus `json:"Status,omitempty"`
+
+	// Type One of Voltage, Current, Power, Clock, Temperature, Load, Frequency, Fan, Flow, Control, Level, Factor,
+	// Data, SmallData, Throughput, TimeSpan, Energy, Noise, Conductivity, Humidity or UnknownSensorType
+	Type *string `json:"Type,omitempty"`
+
+	// Unit Unit of the value, e.g. Celsius or Megahertz. UnknownUnit is returned for the sensors of an unknown type
+	Unit *string `json:"Unit,omitempty"`
+
+	// Value Describes a sensor value
+	Value *SensorValue `json:"Value,omitempty"`
+}
+
+// SensorHistory Describes the recorded values of a sensor
+type SensorHistory struct {
+	// Sensor Describes a sensor
+	Sensor *SensorV2 `json:"Sensor,omitempty"`
+
+	// Values The recorded values, oldest first
+	Values *[]SensorValueV2 `json:"Values,omitempty"`
+}
+
+// SensorStatus Describes the health of a sensor
+type SensorStatus struct {
+	// Reason Which threshold the value crossed, e.g. value 96 is above the critical threshold 95
+	Reason *string `json:"Reason,omitempty"`
+
+	// Sensor Describes a sensor
+	Sensor *SensorV2 `json:"Sensor,omitempty"`
+
+	// Status Health judged by the sensor thresholds, the sensor without a matching threshold is OK
+	Status *HealthStatus `json:"Status,omitempty"`
+}
+
+// SensorV2 Describes a sensor
+type SensorV2 struct {
+	HardwareID *string `json:"HardwareID,omitempty"`
+	ID         *string `json:"ID,omitempty"`
+
+	// Index Position of the sensor among the sensors of the same type of the hardware
+	Index *int    `json:"Index,omitempty"`
+	Name  *string `json:"Name,omitempty"`
+
+	// RawType Type reported by the sensor backend, present only if the Type is Unknown
+	RawType *string `json:"RawType,omitempty"`
+
+	// Status Health judged by the sensor thresholds, the sensor without a matching threshold is OK
+	Status *HealthStatus `json:"Status,omitempty"`
+
+	// Type One of Voltage, Current, Power, Clock, Temperature, Load, Frequency, Fan, Flow, Control, Level, Factor,
+	// Data, SmallData, Throughput, TimeSpan, Energy, Noise, Conductivity, Humidity or UnknownSensorType
+	Type *string `json:"Type,omitempty"`
+
+	// Unit Unit of the value, e.g. Celsius or Megahertz. UnknownUnit is returned for the sensors of an unknown type
+	Unit *string `json:"Unit,omitempty"`
+
+	// Value Describes a sensor value
+	Value *SensorValueV2 `json:"Value,omitempty"`
+}
+
+// SensorValue Describes a sensor value
+type SensorValue struct {
+	// Max Highest value observed since the sensor backend was started
+	Max *int64 `json:"Max,omitempty"`
+
+	// Min Lowest value observed since the sensor backend was started
+	Min       *int64 `json:"Min,omitempty"`
+	Timestamp *int64 `json:"Timestamp,omitempty"`
+	Value     *int64 `json:"Value,omitempty"`
+}
+
+// SensorValueV2 Describes a sensor value
+type SensorValueV2 struct {
+	// Max Highest value observed since the sensor backend was started
+	Max *float64 `json:"Max,omitempty"`
+
+	// Min Lowest value observed since the sensor backend was started
+	Min       *float64 `json:"Min,omitempty"`
+	Timestamp *int64   `json:"Timestamp,omitempty"`
+	Value     *float64 `json:"Value,omitempty"`
+}
+
+// Stats Describes a response to the GetStats endpoint, the values are rounded to integers
+type Stats struct {
+	Hardware *[]Hardware `json:"Hardware,omitempty"`
+
+	// Warnings Issues that didn't fail the request, e.g. the hardware or sensors of an unknown type
+	Warnings *[]string `json:"Warnings,omitempty"`
+}
+
+// StatsHistory Describes a response to the GetStatsHistory endpoint
+type StatsHistory struct {
+	Sensors *[]SensorHistory `json:"Sensors,omitempty"`
+}
+
+// StatsV2 Describes a response to the GetStatsV2 endpoint
+type StatsV2 struct {
+	// Age Seconds since the stats were read from the sensor backend. Absent from the stream events.
+	Age      *float64      `json:"Age,omitempty"`
+	Hardware *[]HardwareV2 `json:"Hardware,omitempty"`
+
+	// Stale Whether the stats are older than APP_STATS_CACHE_RETENTION: they are served while being refreshed
+	// in the background, or because the refresh failed. The failed refresh is returned as an error once
+	// the stats are older than APP_STATS_CACHE_MAX_FALLBACK_AGE. Absent from the stream events.
+	Stale *bool `json:"Stale,omitempty"`
+
+	// Warnings Issues that didn't fail the request, e.g. the hardware or sensors of an unknown type
+	Warnings *[]string `json:"Warnings,omitempty"`
+}
+
+// HardwareIDFilter defines model for HardwareIDFilter.
+type HardwareIDFilter = []string
+
+// HardwareIDPath defines model for HardwareIDPath.
+type HardwareIDPath = string
+
+// HardwareTypeFilter defines model for HardwareTypeFilter.
+type HardwareTypeFilter = []string
+
+// NameFilter defines model for NameFilter.
+type NameFilter = []string
+
+// SensorIDFilter defines model for SensorIDFilter.
+type SensorIDFilter = []string
+
+// SensorIDPath defines model for SensorIDPath.
+type SensorIDPath = string
+
+// SensorTypeFilter defines model for SensorTypeFilter.
+type SensorTypeFilter = []string
+
+// UnitsPreference defines model for UnitsPreference.
+type UnitsPreference = []string
+
+// GetStatsParams defines parameters for GetStats.
+type GetStatsParams struct {
+	// HardwareType Returns only the hardware of the types, e.g. CPU or GPU. Repeat the parameter to pass several types.
+	HardwareType *HardwareTypeFilter `form:"hardwareType,omitempty" json:"hardwareType,omitempty"`
+
+	// SensorType Returns only the sensors of the types, e.g. Temperature or Fan. Repeat the parameter to pass several types.
+	SensorType *SensorTypeFilter `form:"sensorType,omitempty" json:"sensorType,omitempty"`
+
+	// HardwareId Returns only the hardware with the IDs. Repeat the parameter to pass several IDs.
+	HardwareId *HardwareIDFilter `form:"hardwareId,omitempty" json:"hardwareId,omitempty"`
+
+	// SensorId Returns only the sensors with the IDs. Repeat the parameter to pass several IDs.
+	SensorId *SensorIDFilter `form:"sensorId,omitempty" json:"sensorId,omitempty"`
+
+	// Name Returns only the sensors whose names match any of the patterns, case-insensitive.
+	// The pattern supports the wildcards '*' matching any sequence of characters and '?' matching a single one.
+	// Repeat the parameter to pass several patterns.
+	Name *NameFilter `form:"name,omitempty" json:"name,omitempty"`
+
+	// Units Converts the sensor values to the preferred units: celsius or fahrenheit for the temperature,
+	// mhz or ghz for the clock, bytes/s, kb/s or mb/s for the throughput, human to show the data sizes
+	// in the largest of MB, GB and TB the value is at least 1 in. The names are case-insensitive.
+	// Repeat the parameter to pass several preferences.
+	Units *UnitsPreference `form:"units,omitempty" json:"units,omitempty"`
+}
+
+// GetStatsHistoryParams defines parameters for GetStatsHistory.
+type GetStatsHistoryParams struct {
+	// SensorId The IDs of the sensors. Repeat the parameter to pass several IDs.
+	SensorId []string `form:"sensorId" json:"sensorId"`
+
+	// Range How far back the values are returned as a duration, e.g. 90s or 5m. All the recorded values are returned if it is absent.
+	Range *string `form:"range,omitempty" json:"range,omitempty"`
+
+	// Units Converts the sensor values to the preferred units: celsius or fahrenheit for the temperature,
+	// mhz or ghz for the clock, bytes/s, kb/s or mb/s for the throughput, human to show the data sizes
+	// in the largest of MB, GB and TB the value is at least 1 in. The names are case-insensitive.
+	// Repeat the parameter to pass several preferences.
+	Units *UnitsPreference `form:"units,omitempty" json:"units,omitempty"`
+}
+
+// StreamStatsParams defines parameters for StreamStats.
+type StreamStatsParams struct {
+	// Interval The minimal number of seconds between the events
+	Interval *int `form:"interval,omitempty" json:"interval,omitempty"`
+
+	// Mode Whether every event carries all the stats or only the changed sensors
+	Mode *StreamStatsParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// HardwareType Returns only the hardware of the types, e.g. CPU or GPU. Repeat the parameter to pass several types.
+	HardwareType *HardwareTypeFilter `form:"hardwareType,omitempty" json:"hardwareType,omitempty"`
+
+	// SensorType Returns only the sensors of the types, e.g. Temperature or Fan. Repeat the parameter to pass several types.
+	SensorType *SensorTypeFilter `form:"sensorType,omitempty" json:"sensorType,omitempty"`
+
+	// HardwareId Returns only the hardware with the IDs. Repeat the parameter to pass several IDs.
+	HardwareId *HardwareIDFilter `form:"hardwareId,omitempty" json:"hardwareId,omitempty"`
+
+	// SensorId Returns only the sensors with the IDs. Repeat the parameter to pass several IDs.
+	SensorId *SensorIDFilter `form:"sensorId,omitempty" json:"sensorId,omitempty"`
+
+	// Name Returns only the sensors whose names match any of the patterns, case-insensitive.
+	// The pattern supports the wildcards '*' matching any sequence of characters and '?' matching a single one.
+	// Repeat the parameter to pass several patterns.
+	Name *NameFilter `form:"name,omitempty" json:"name,omitempty"`
+
+	// Units Converts the sensor values to the preferred units: celsius or fahrenheit for the temperature,
+	// mhz or ghz for the clock, bytes/s, kb/s or mb/s for the throughput, human to show the data sizes
+	// in the largest of MB, GB and TB the value is at least 1 in. The names are case-insensitive.
+	// Repeat the parameter to pass several preferences.
+	Units *UnitsPreference `form:"units,omitempty" json:"units,omitempty"`
+
+	// LastEventID ID of the last event received before reconnecting
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// StreamStatsParamsMode defines parameters for StreamStats.
+type StreamStatsParamsMode string
+
+// GetStatsV2Params defines parameters for GetStatsV2.
+type GetStatsV2Params struct {
+	// Layout The shape of the hardware list: flat returns all the hardware as siblings,
+	// tree nests the hardware under its parent the way LibreHardwareMonitor shows it.
+	Layout *GetStatsV2ParamsLayout `form:"layout,omitempty" json:"layout,omitempty"`
+
+	// HardwareType Returns only the hardware of the types, e.g. CPU or GPU. Repeat the parameter to pass several types.
+	HardwareType *HardwareTypeFilter `form:"hardwareType,omitempty" json:"hardwareType,omitempty"`
+
+	// SensorType Returns only the sensors of the types, e.g. Temperature or Fan. Repeat the parameter to pass several types.
+	SensorType *SensorTypeFilter `form:"sensorType,omitempty" json:"sensorType,omitempty"`
+
+	// HardwareId Returns only the hardware with the IDs. Repeat the parameter to pass several IDs.
+	HardwareId *HardwareIDFilter `form:"hardwareId,omitempty" json:"hardwareId,omitempty"`
+
+	// SensorId Returns only the sensors with the IDs. Repeat the parameter to pass several IDs.
+	SensorId *SensorIDFilter `form:"sensorId,omitempty" json:"sensorId,omitempty"`
+
+	// Name Returns only the sensors whose names match any of the patterns, case-insensitive.
+	// The pattern supports the wildcards '*' matching any sequence of characters and '?' matching a single one.
+	// Repeat the parameter to pass several patterns.
+	Name *NameFilter `form:"name,omitempty" json:"name,omitempty"`
+
+	// Units Converts the sensor values to the preferred units: celsius or fahrenheit for the temperature,
+	// mhz or ghz for the clock, bytes/s, kb/s or mb/s for the throughput, human to show the data sizes
+	// in the largest of MB, GB and TB the value is at least 1 in. The names are case-insensitive.
+	// Repeat the parameter to pass several preferences.
+	Units *UnitsPreference `form:"units,omitempty" json:"units,omitempty"`
+}
+
+// GetStatsV2ParamsLayout defines parameters for GetStatsV2.
+type GetStatsV2ParamsLayout string
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Returns the health status of the API.
+	// (GET /health)
+	HealthCheck(ctx echo.Context) error
+	// Returns a map of hardware stats.
+	// (GET /stats)
+	GetStats(ctx echo.Context, params GetStatsParams) error
+	// Returns the recent values of the sensors.
+	// (GET /stats/history)
+	GetStatsHistory(ctx echo.Context, params GetStatsHistoryParams) error
+	// Streams the hardware stats as Server-Sent Events.
+	// (GET /stats/stream)
+	StreamStats(ctx echo.Context, params StreamStatsParams) error
+	// Returns the health of the host judged by the sensor thresholds.
+	// (GET /status)
+	GetStatus(ctx echo.Context) error
+	// Returns the hardware without its sensors.
+	// (GET /v2/hardware)
+	ListHardwareV2(ctx echo.Context) error
+	// Returns the hardware with its sensors.
+	// (GET /v2/hardware/{id})
+	GetHardwareV2(ctx echo.Context, id HardwareIDPath) error
+	// Returns the sensors of the hardware.
+	// (GET /v2/hardware/{id}/sensors)
+	ListHardwareSensorsV2(ctx echo.Context, id HardwareIDPath) error
+	// Returns a single sensor.
+	// (GET /v2/sensors/{id})
+	GetSensorV2(ctx echo.Context, id SensorIDPath) error
+	// Returns a map of hardware stats.
+	// (GET /v2/stats)
+	GetStatsV2(ctx echo.Context, params GetStatsV2Params) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// HealthCheck converts echo context to params.
+func (w *ServerInterfaceWrapper) HealthCheck(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HealthCheck(ctx)
+	return err
+}
+
+// GetStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsParams
+	// ------------- Optional query parameter "hardwareType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hardwareType", ctx.QueryParams(), &params.HardwareType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hardwareType: %s", err))
+	}
+
+	// ------------- Optional query parameter "sensorType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sensorType", ctx.QueryParams(), &params.SensorType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sensorType: %s", err))
+	}
+
+	// ------------- Optional query parameter "hardwareId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hardwareId", ctx.QueryParams(), &params.HardwareId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hardwareId: %s", err))
+	}
+
+	// ------------- Optional query parameter "sensorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sensorId", ctx.QueryParams(), &params.SensorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sensorId: %s", err))
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "units" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "units", ctx.QueryParams(), &params.Units)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter units: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStats(ctx, params)
+	return err
+}
+
+// GetStatsHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsHistory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsHistoryParams
+	// ------------- Required query parameter "sensorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "sensorId", ctx.QueryParams(), &params.SensorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sensorId: %s", err))
+	}
+
+	// ------------- Optional query parameter "range" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "range", ctx.QueryParams(), &params.Range)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter range: %s", err))
+	}
+
+	// ------------- Optional query parameter "units" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "units", ctx.QueryParams(), &params.Units)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter units: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsHistory(ctx, params)
+	return err
+}
+
+// StreamStats converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamStatsParams
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", ctx.QueryParams(), &params.Interval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+	}
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", ctx.QueryParams(), &params.Mode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mode: %s", err))
+	}
+
+	// ------------- Optional query parameter "hardwareType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hardwareType", ctx.QueryParams(), &params.HardwareType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hardwareType: %s", err))
+	}
+
+	// ------------- Optional query parameter "sensorType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sensorType", ctx.QueryParams(), &params.SensorType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sensorType: %s", err))
+	}
+
+	// ------------- Optional query parameter "hardwareId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hardwareId", ctx.QueryParams(), &params.HardwareId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hardwareId: %s", err))
+	}
+
+	// ------------- Optional query parameter "sensorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sensorId", ctx.QueryParams(), &params.SensorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sensorId: %s", err))
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "units" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "units", ctx.QueryParams(), &params.Units)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter units: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamStats(ctx, params)
+	return err
+}
+
+// GetStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatus(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatus(ctx)
+	return err
+}
+
+// ListHardwareV2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListHardwareV2(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListHardwareV2(ctx)
+	return err
+}
+
+// GetHardwareV2 converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHardwareV2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id HardwareIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHardwareV2(ctx, id)
+	return err
+}
+
+// ListHardwareSensorsV2 converts echo context to params.
+func (w *ServerInterfaceWrapper) ListHardwareSensorsV2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id HardwareIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListHardwareSensorsV2(ctx, id)
+	return err
+}
+
+// GetSensorV2 converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSensorV2(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id SensorIDPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSensorV2(ctx, id)
+	return err
+}
+
+// GetStatsV2 converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsV2(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsV2Params
+	// ------------- Optional query parameter "layout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "layout", ctx.QueryParams(), &params.Layout)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter layout: %s", err))
+	}
+
+	// ------------- Optional query parameter "hardwareType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hardwareType", ctx.QueryParams(), &params.HardwareType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hardwareType: %s", err))
+	}
+
+	// ------------- Optional query parameter "sensorType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sensorType", ctx.QueryParams(), &params.SensorType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sensorType: %s", err))
+	}
+
+	// ------------- Optional query parameter "hardwareId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hardwareId", ctx.QueryParams(), &params.HardwareId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hardwareId: %s", err))
+	}
+
+	// ------------- Optional query parameter "sensorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sensorId", ctx.QueryParams(), &params.SensorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sensorId: %s", err))
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "units" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "units", ctx.QueryParams(), &params.Units)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter units: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsV2(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/health", wrapper.HealthCheck)
+	router.GET(baseURL+"/stats", wrapper.GetStats)
+	router.GET(baseURL+"/stats/history", wrapper.GetStatsHistory)
+	router.GET(baseURL+"/stats/stream", wrapper.StreamStats)
+	router.GET(baseURL+"/status", wrapper.GetStatus)
+	router.GET(baseURL+"/v2/hardware", wrapper.ListHardwareV2)
+	router.GET(baseURL+"/v2/hardware/:id", wrapper.GetHardwareV2)
+	router.GET(baseURL+"/v2/hardware/:id/sensors", wrapper.ListHardwareSensorsV2)
+	router.GET(baseURL+"/v2/sensors/:id", wrapper.GetSensorV2)
+	router.GET(baseURL+"/v2/stats", wrapper.GetStatsV2)
+
+}
+
+type HealthCheckRequestObject struct {
+}
+
+type HealthCheckResponseObject interface {
+	VisitHealthCheckResponse(w http.ResponseWriter) error
+}
+
+type HealthCheck200TextResponse string
+
+func (response HealthCheck200TextResponse) VisitHealthCheckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetStatsRequestObject struct {
+	Params GetStatsParams
+}
+
+type GetStatsResponseObject interface {
+	VisitGetStatsResponse(w http.ResponseWriter) error
+}
+
+type GetStats200JSONResponse Stats
+
+func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats400JSONResponse Error
+
+func (response GetStats400JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats500JSONResponse Error
+
+func (response GetStats500JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsHistoryRequestObject struct {
+	Params GetStatsHistoryParams
+}
+
+type GetStatsHistoryResponseObject interface {
+	VisitGetStatsHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetStatsHistory200JSONResponse StatsHistory
+
+func (response GetStatsHistory200JSONResponse) VisitGetStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsHistory400JSONResponse Error
+
+func (response GetStatsHistory400JSONResponse) VisitGetStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsHistory404JSONResponse Error
+
+func (response GetStatsHistory404JSONResponse) VisitGetStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsHistory500JSONResponse Error
+
+func (response GetStatsHistory500JSONResponse) VisitGetStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsHistory501JSONResponse Error
+
+func (response GetStatsHistory501JSONResponse) VisitGetStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamStatsRequestObject struct {
+	Params StreamStatsParams
+}
+
+type StreamStatsResponseObject interface {
+	VisitStreamStatsResponse(w http.ResponseWriter) error
+}
+
+type StreamStats200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamStats200TexteventStreamResponse) VisitStreamStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamStats400JSONResponse Error
+
+func (response StreamStats400JSONResponse) VisitStreamStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatusRequestObject struct {
+}
+
+type GetStatusResponseObject interface {
+	VisitGetStatusResponse(w http.ResponseWriter) error
+}
+
+type GetStatus200JSONResponse HostStatus
+
+func (response GetStatus200JSONResponse) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatus500JSONResponse Error
+
+func (response GetStatus500JSONResponse) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListHardwareV2RequestObject struct {
+}
+
+type ListHardwareV2ResponseObject interface {
+	VisitListHardwareV2Response(w http.ResponseWriter) error
+}
+
+type ListHardwareV2200JSONResponse []HardwareV2
+
+func (response ListHardwareV2200JSONResponse) VisitListHardwareV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListHardwareV2500JSONResponse Error
+
+func (response ListHardwareV2500JSONResponse) VisitListHardwareV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Sensor Describes a sensor
-type Sensor struct {
-	ID   *string `json:"ID,omitempty"`
-	Name *string `json:"Name,omitempty"`
-	Type *string `json:"Type,omitempty"`
+type GetHardwareV2RequestObject struct {
+	Id HardwareIDPath `json:"id"`
+}
 
-	// Value Describes a sensor value
-	Value *SensorValue `json:"Value,omitempty"`
+type GetHardwareV2ResponseObject interface {
+	VisitGetHardwareV2Response(w http.ResponseWriter) error
 }
 
-// SensorValue Describes a sensor value
-type SensorValue struct {
-	Timestamp *int64 `json:"Timestamp,omitempty"`
-	Value     *int64 `json:"Value,omitempty"`
+type GetHardwareV2200JSONResponse HardwareV2
+
+func (response GetHardwareV2200JSONResponse) VisitGetHardwareV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Stats Describes a response to the GetStats endpoint
-type Stats struct {
-	Hardware *[]Hardware `json:"Hardware,omitempty"`
+type GetHardwareV2404JSONResponse Error
+
+func (response GetHardwareV2404JSONResponse) VisitGetHardwareV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Returns the health status of the API.
-	// (GET /health)
-	HealthCheck(ctx echo.Context) error
-	// Returns a map of hardware stats.
-	// (GET /stats)
-	GetStats(ctx echo.Context) error
+type GetHardwareV2500JSONResponse Error
+
+func (response GetHardwareV2500JSONResponse) VisitGetHardwareV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+type ListHardwareSensorsV2RequestObject struct {
+	Id HardwareIDPath `json:"id"`
 }
 
-// HealthCheck converts echo context to params.
-func (w *ServerInterfaceWrapper) HealthCheck(ctx echo.Context) error {
-	var err error
+type ListHardwareSensorsV2ResponseObject interface {
+	VisitListHardwareSensorsV2Response(w http.ResponseWriter) error
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HealthCheck(ctx)
-	return err
+type ListHardwareSensorsV2200JSONResponse []SensorV2
+
+func (response ListHardwareSensorsV2200JSONResponse) VisitListHardwareSensorsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetStats converts echo context to params.
-func (w *ServerInterfaceWrapper) GetStats(ctx echo.Context) error {
-	var err error
+type ListHardwareSensorsV2404JSONResponse Error
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetStats(ctx)
-	return err
+func (response ListHardwareSensorsV2404JSONResponse) VisitListHardwareSensorsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+type ListHardwareSensorsV2500JSONResponse Error
+
+func (response ListHardwareSensorsV2500JSONResponse) VisitListHardwareSensorsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+type GetSensorV2RequestObject struct {
+	Id SensorIDPath `json:"id"`
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+type GetSensorV2ResponseObject interface {
+	VisitGetSensorV2Response(w http.ResponseWriter) error
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+type GetSensorV2200JSONResponse SensorV2
 
-	router.GET(baseURL+"/health", wrapper.HealthCheck)
-	router.GET(baseURL+"/stats", wrapper.GetStats)
+func (response GetSensorV2200JSONResponse) VisitGetSensorV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
+	return json.NewEncoder(w).Encode(response)
 }
 
-type HealthCheckRequestObject struct {
-}
+type GetSensorV2404JSONResponse Error
 
-type HealthCheckResponseObject interface {
-	VisitHealthCheckResponse(w http.ResponseWriter) error
+func (response GetSensorV2404JSONResponse) VisitGetSensorV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type HealthCheck200TextResponse string
+type GetSensorV2500JSONResponse Error
 
-func (response HealthCheck200TextResponse) VisitHealthCheckResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(200)
+func (response GetSensorV2500JSONResponse) VisitGetSensorV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	_, err := w.Write([]byte(response))
-	return err
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetStatsRequestObject struct {
+type GetStatsV2RequestObject struct {
+	Params GetStatsV2Params
 }
 
-type GetStatsResponseObject interface {
-	VisitGetStatsResponse(w http.ResponseWriter) error
+type GetStatsV2ResponseObject interface {
+	VisitGetStatsV2Response(w http.ResponseWriter) error
 }
 
-type GetStats200JSONResponse Stats
+type GetStatsV2200JSONResponse StatsV2
 
-func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+func (response GetStatsV2200JSONResponse) VisitGetStatsV2Response(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetStats500JSONResponse Error
+type GetStatsV2400JSONResponse Error
 
-func (response GetStats500JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+func (response GetStatsV2400JSONResponse) VisitGetStatsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsV2500JSONResponse Error
+
+func (response GetStatsV2500JSONResponse) VisitGetStatsV2Response(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
@@ -171,6 +1110,30 @@ type StrictServerInterface interface {
 	// Returns a map of hardware stats.
 	// (GET /stats)
 	GetStats(ctx context.Context, request GetStatsRequestObject) (GetStatsResponseObject, error)
+	// Returns the recent values of the sensors.
+	// (GET /stats/history)
+	GetStatsHistory(ctx context.Context, request GetStatsHistoryRequestObject) (GetStatsHistoryResponseObject, error)
+	// Streams the hardware stats as Server-Sent Events.
+	// (GET /stats/stream)
+	StreamStats(ctx context.Context, request StreamStatsRequestObject) (StreamStatsResponseObject, error)
+	// Returns the health of the host judged by the sensor thresholds.
+	// (GET /status)
+	GetStatus(ctx context.Context, request GetStatusRequestObject) (GetStatusResponseObject, error)
+	// Returns the hardware without its sensors.
+	// (GET /v2/hardware)
+	ListHardwareV2(ctx context.Context, request ListHardwareV2RequestObject) (ListHardwareV2ResponseObject, error)
+	// Returns the hardware with its sensors.
+	// (GET /v2/hardware/{id})
+	GetHardwareV2(ctx context.Context, request GetHardwareV2RequestObject) (GetHardwareV2ResponseObject, error)
+	// Returns the sensors of the hardware.
+	// (GET /v2/hardware/{id}/sensors)
+	ListHardwareSensorsV2(ctx context.Context, request ListHardwareSensorsV2RequestObject) (ListHardwareSensorsV2ResponseObject, error)
+	// Returns a single sensor.
+	// (GET /v2/sensors/{id})
+	GetSensorV2(ctx context.Context, request GetSensorV2RequestObject) (GetSensorV2ResponseObject, error)
+	// Returns a map of hardware stats.
+	// (GET /v2/stats)
+	GetStatsV2(ctx context.Context, request GetStatsV2RequestObject) (GetStatsV2ResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -209,9 +1172,11 @@ func (sh *strictHandler) HealthCheck(ctx echo.Context) error {
 }
 
 // GetStats operation middleware
-func (sh *strictHandler) GetStats(ctx echo.Context) error {
+func (sh *strictHandler) GetStats(ctx echo.Context, params GetStatsParams) error {
 	var request GetStatsRequestObject
 
+	request.Params = params
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetStats(ctx.Request().Context(), request.(GetStatsRequestObject))
 	}
@@ -231,19 +1196,265 @@ func (sh *strictHandler) GetStats(ctx echo.Context) error {
 	return nil
 }
 
+// GetStatsHistory operation middleware
+func (sh *strictHandler) GetStatsHistory(ctx echo.Context, params GetStatsHistoryParams) error {
+	var request GetStatsHistoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsHistory(ctx.Request().Context(), request.(GetStatsHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetStatsHistoryResponseObject); ok {
+		return validResponse.VisitGetStatsHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StreamStats operation middleware
+func (sh *strictHandler) StreamStats(ctx echo.Context, params StreamStatsParams) error {
+	var request StreamStatsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamStats(ctx.Request().Context(), request.(StreamStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamStats")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StreamStatsResponseObject); ok {
+		return validResponse.VisitStreamStatsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetStatus operation middleware
+func (sh *strictHandler) GetStatus(ctx echo.Context) error {
+	var request GetStatusRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatus(ctx.Request().Context(), request.(GetStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetStatusResponseObject); ok {
+		return validResponse.VisitGetStatusResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListHardwareV2 operation middleware
+func (sh *strictHandler) ListHardwareV2(ctx echo.Context) error {
+	var request ListHardwareV2RequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListHardwareV2(ctx.Request().Context(), request.(ListHardwareV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListHardwareV2")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListHardwareV2ResponseObject); ok {
+		return validResponse.VisitListHardwareV2Response(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetHardwareV2 operation middleware
+func (sh *strictHandler) GetHardwareV2(ctx echo.Context, id HardwareIDPath) error {
+	var request GetHardwareV2RequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHardwareV2(ctx.Request().Context(), request.(GetHardwareV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHardwareV2")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetHardwareV2ResponseObject); ok {
+		return validResponse.VisitGetHardwareV2Response(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListHardwareSensorsV2 operation middleware
+func (sh *strictHandler) ListHardwareSensorsV2(ctx echo.Context, id HardwareIDPath) error {
+	var request ListHardwareSensorsV2RequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListHardwareSensorsV2(ctx.Request().Context(), request.(ListHardwareSensorsV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListHardwareSensorsV2")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListHardwareSensorsV2ResponseObject); ok {
+		return validResponse.VisitListHardwareSensorsV2Response(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSensorV2 operation middleware
+func (sh *strictHandler) GetSensorV2(ctx echo.Context, id SensorIDPath) error {
+	var request GetSensorV2RequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSensorV2(ctx.Request().Context(), request.(GetSensorV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSensorV2")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSensorV2ResponseObject); ok {
+		return validResponse.VisitGetSensorV2Response(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetStatsV2 operation middleware
+func (sh *strictHandler) GetStatsV2(ctx echo.Context, params GetStatsV2Params) error {
+	var request GetStatsV2RequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsV2(ctx.Request().Context(), request.(GetStatsV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsV2")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetStatsV2ResponseObject); ok {
+		return validResponse.VisitGetStatsV2Response(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/6xVTW/TQBD9K6uBoxWnfB18Qy2iEQIqWsEBcdjak3hbe3c1My5Ulf872nGclsRNI+CU",
-	"Xc/Xe2/fbu6gDG0MHr0wFHfAZY2t1eU7okBpUSGX5KK44KGAE91dIhvrDaYcQ8gxeEbIIFKISOJQW3xE",
-	"ZrvCtJTbiFAACzm/gj6Dc7HS8XGoNLwM1FqBApyXN68gG/OdF1whQd9vPoXLKywltTi1VP20hHtBmnqd",
-	"ZTZMd3AuTiYhfrLtI9jRcyAtdYKtLp4TLqGAZ/m9oPlazXzIh3sOlsjepv2F7ndGTLFdN9nLlYecf+X3",
-	"CKoMvtqmw8PYDql7mGx6PUXH3GjmNqkL1yKLbeNB/nmA/a+8luzK+9GO18BIMFKjeY+iVQZ9FYOb8N1D",
-	"Ax9kpE3BjpV2MadPzi/DLuiL2rFxrCDfni1MFcquRS82xc0ykEa+OW8G/GeuvEZKqbOklpMmzZmKQwY3",
-	"SDyMOZrNZ/OENET0Njoo4OXsaDZPMliplWxeo22kTssVSvpJ+iiQRQUFnGr4uMbyGjIYFdbSF/N5+imD",
-	"l3Snk13xl+Sxsc7fP2VTl6vPtgT5/EEF5K5tLd1CAV9QOvKDQgNCw/pembAcZZtpTc6jMdb4J6Qez9/Q",
-	"uq01jWNJvTaPk7ZJ8v7Jf/TQ0+RtjI0rtTK/4rAlwd77qgMekSWD1/9x1PCfMjFq4QXJ28acI90gmTFx",
-	"6lCsaW2cEk/7sjZgKL5vn0Wy+hCFDDpqoIAc+h/97wAAAP//k8qqYwQHAAA=",
+	"H4sIAAAAAAAC/+xbeW/bOBb/Kg/aHRRYMHaa7Qww3j8WztUYzWHEbruDpgho6dniRCJVkorHHeS7L3jo",
+	"sC05ztFpB8hfiSUej+/4vYNPfwahSDPBkWsV9P4MMippihql/XVCZTSnEgeHxyzRKM2zCFUoWaaZ4EEv",
+	"uESdS65A8GQBOkaI/RSYMx3bJ4ND1YFLzJBq+7vcArSAjCoFCm9R0sSODEjAzMJfcpSLgAScphj0gmLZ",
+	"QRSQQIUxptQQwzSmllK9yMwwpSXjs+COFA+olHQR3N2R2lmGVMfrJxnXiR8cEshQhsj1DvJQRBiBYjxE",
+	"YBpCwTVlXIFKqIpREcDOrAM/7R0zrjEJs/ynvePd8iSZ2a48CDMHkPglZxKjoKdljvUDrZyjRvd4keHD",
+	"pSCm9rdZtqDzYPgehIS3w/dbisVOvk8whrzHiuacptsfTSFXQiqYx0IhGCoUpFSHMVC+KM6bUa1RckUg",
+	"pAp3GDezmGa32Lni42oAqDzLhNTKzpqzJAqpjBS8+tcrtyjjM7uuwi85GvmLKYQxlTTUKBVQHsGr/9bH",
+	"GjWZJQiCm6224m9BbOeKtzDZ/nkkc0eWXw+w4JLBz2rAbtVB9NRjtBuv2+E5TPenvWONaYaS6lzicxqz",
+	"O8WDTLkQR4MljysijUUfU/4cFq1KGh8rq/ecaTWUOEVpbGb9kAeC32JhdV5utzTJURlSLe12tsQIcrNY",
+	"D0JMFMuVOeeUxhJ5jEzDVEjHlYoT5Iqn8VczbhZ/LQeEiQhvCEwWGlVXEbiZdO1aqflbrhJLkc/iLNcE",
+	"4jyl3FCjYjG3byOqjXV/RXXFGbePEipnqLSRzdk+gbf7FhDG+/alPRAwBVRDglRpeA2Md8DoqoMtKrEJ",
+	"n7YDjZK9G3DD8u5xUrwrZtnRR1KKBmU9tL8m5igc0IwBiSoTXGFAgkyKDKVmaJc4Q6XoDBv3Hmmqc3Ug",
+	"Ivt6KmRKtTEvrn95E5SkGROdobQq5h+Jye8Y6qDmJjcSWfnFMupZo3Nw2EjiueVoE+3OPpeY+0+J06AX",
+	"/KNbbqO6nptdN36d4yQY5RP3aA3dFhmCROOoMIJJHRdgQsMb5JFHhLdZfn7LIkYJaEwSBTSjUjeGBIqm",
+	"CN7K1w41bqTjgtvZZ0LHKCeCyojAKM9QDi6ICSuICSoInGEq5ILAZf+MwEgLSWdI4OTwkMA56rmQNwQO",
+	"hEhQEjhKJxhFGB0IrqVIzLMrPhy9J7BvveKCwHifJpSHZvQJUn1GlUZpbPc9v+FizusB0hVfP80mbXGK",
+	"t0lnLOuQJjo2R//WGtTm1YpwR1l6DahwoeHiXUAeonT+tE2qV/Jh0yonlg/VKoWWPIDhH/aebKAHMUsi",
+	"ibyZXxyVMZJiHQLSOlSMnEctoV6ige+FyPW2PKwdoYGDDxX6kErkenC4forBYWGiJTN0zBQI7r2JpmGM",
+	"EWhBgE4U8pobFNlOgreYlFObjPuSzsePx5lMot3TspM5Qu1EpgqLbNr0cTD5Ye8FKL8vUNZNfu2c7i38",
+	"nkezNX7rWKKKRRIpUn9ssgqRa6BVzlSONCpkMQ15nga9T4H98bF/eR6Q4OByMA4+N4jgRCh9P47TMjIp",
+	"Qsy36KcB8igTrAFq6kHFgzDieZG2STA+jNh4Ysfybf3TgEf4x/qCQ2ECU8FLc3BypKngs9oDtWQuZvFV",
+	"FGsI5Dbg4/cBqSe5wUYM+CASbc36IJcSuSYwFHNjogcuI6nlcAROBY0IHEtXbFgQk9IROE7EnIA3fAKn",
+	"BuDNq1ALAwKHVFMCo5Qmift3XEtjxizFUWZWOeIoZwsC54IptMtFeajZLdMLAid5yiKmFzW0qFLVq0Ze",
+	"mSRv/dTmaSF4mwAVRacqfTvDGY1R6q+dYis7ianKVRf+rKZalEPuRoNupemD2XFLz2KHbjCtE6a0kIv7",
+	"YkOJoZCmxuDzV0Nqm+FVNrut57NUtkSGKzsTEEmESsOUSaUfFhjabZp8bTt7Hh45tzDlEqkSDcHcx5iF",
+	"cc03VCl1KIVSWHhy9+zXX2xsNBG3aEeGkmkW0qS2wK8/t8clD5HJ8yL4fQFxC9eqanYzlr9A/AvEv0C8",
+	"R7UN1lesdp8BupOuF7VogzmdsFmMSrspICYK5W1ZhF5XZZhTBUpTo+wBub/6RYIz1oCXp2L+TTc1aqY0",
+	"TbOtSnQ1QT2qnLcswR9TQJHIJ0kN83ieTr65gNo3fbqA2tZuFJCm+nEJV5VvkQpDXDFcipxHtrQBnlD1",
+	"fDlZUzb2kUrO+KzhIAOlchvEUA0Ri/grDVPKEh/zfclRaY97yzUDuQHR6kHZ/bcozSzfIixt57yf3J7w",
+	"Pq5KU9C0/Sk+7D3uAB/22mnvzxqQfISh4JGqm5lVwTlKBIk0gqkUaYP9daDva2vley2RpoC3hgOd7Wzy",
+	"0craUvjSNMGmUBl1jLJ2PKuKSWSfUQ794fB6NO6PR9cH/YOTo+vLo/HR+Xhwcd4zcxZ2uMejecwShAma",
+	"wozEqUQVY1ReeBnmzKyVEqPqEwxprtAbhR1srQQjd83l/i9f1f0/rd0ZCR7iFd+a+rP+/66P+6en+/2D",
+	"d9f9t0f3iaoeSExMOY3yv5nt35FAYZhLphcjoyhO4feRSpT9vOlC/NJfSi+FxxkLb1ACKx2Lu+M3/D0Z",
+	"j4fXo6PLD0eX1/3345Pr8cW7o/MR8WXlG+TgS9Au8kt9H0XXZ3iFVZpRNJnThQKRIXe8t8ptuW8prqQR",
+	"a525u0bGp6IpzWX2ssMQ0R8OIBJhniLX1LwvQ8iPjINzLEN3wP5wYOxTM22sJWh6H5DgFqVy27zu7HZ2",
+	"jRwMyTRjQS/4d+d1Zzcg9r7fMtsf1Pw7QxsVCxvUM8EHUVkKPYgxvAlIUOCXnbq3u2v+hIJr5Haqxj90",
+	"N0uoCxPaGwbuyGrG8W5JGYLep88kUHmaUrmoNQ/UUm9/Y+TjdcsZs0JXFf7bnybCTGJIddXH0CCKUsrS",
+	"b0QhYe7+u7QCu7DXjs2unYDBju7tniPGvJqhLq7/Q6bQKFzDWqFrH3CrNXQL2Ft4HSMvdvXWutt5A29P",
+	"vhrYEikq2HXquSzIwtVY4VfNcJ+acbsa0m1o1Loj985a6wi5I1vvNDjcfs5KG9AWM2pdWVuMXu35uPt8",
+	"rxXQLEtYaDnf/V2JFVvYGHZYAbXYBwnePONWrvOhYat9GsGlcwZmz5//ij0HXKPkNIGRcdYSioFNGGAu",
+	"WLIGy6yZfzeugskSBu43+lrQXtYgJ4uV+MAUibKE8RkBJYBCmDDk2vlUk9qI6TRhHK+4QXHqw46Qcpiy",
+	"xHnaGc1cFFGsBJYYOkncftZtDUbji8vfro/O+/unR4fOXbnwa6lEoa74DWYaMix8tFksYSnT64sd9If9",
+	"g8H4t03oUIS8ayCxXqcdHKrlwtrzdtO1t5+1xxsp4wP38vV68LGWLYs5TKkLjNcStno4B1HuOOXh9tdd",
+	"Wwz6Oe1AP0kaq+VLa7ApuCKRu9Vub2uSlM+Wm9PWbjJ/QLwqs6QfCbbe7L759nueCw3HPmnguGIMEFMF",
+	"XKyqxvfGVLP767+GNYM0SzBFrk2g0oKixiwipiz4tQC+ty/kunYXVUedOvK7DGlL4M9yFRcXOkvOxJi9",
+	"Y9vOyOx7ZBMuAtSbu9tFgYrNlLI3ujxUIkTmwzubq7keS6bAJ/uOHe6V9rV9p0G9Kw6wA4rTTMVC92pb",
+	"GrpqvQVoPIpGqf7jpoSxQQ/VW++wtb7JEMpxboDLtVx5Xrpp9XpdJvGWiVw5+ohZHcDmoXSqfTZu7+JK",
+	"Kos8LBWRPYonxXk5iamwBUFPjSVE6LV7ED/J72fpL5dXbn/GlUYa2by6KpAyBTPB0fpju5DzyBKzhIao",
+	"Kg56ApiGG8Ss4JtN1Xs1HociT2xuPHGVFFJ1yTLlzIjUs/GZMCppUsK+NXbOMdRGRp4Q4/u8llUtUAlV",
+	"hsQQmeGN0wRfizilSu9YjdsZHJp0J7I9MTUeFTvbuoPKUyyrPVQXaxU+x1sOcnOvQsB27syZsl3jNlv2",
+	"DfG0ZHdTeDCyG7bkD+uhQco4S2lSi1iUr1dNUM8ReaX/qsUXMq5R3tJkyR1GOKV5oq17t3vkad3V1yrv",
+	"baUkE4IsPI9CKiWrK4gVv5CVBZXG4VSnhVSj9s1kBgVPa70/tUdenA3dP+sHWFEdd4JSgSY4FRKX1K8g",
+	"1ilQRe2Sej0x2HhJDR8YatkCiZXdTuWpHlgn+R4B1ZJjHnnvt63frDnoXG2bk4kkcTt4lHezUUGeAeO+",
+	"PlIVgZxdl9SYQklxhy+U9vA5F9ZruUlzxr2Hqjr6rHNKBI2wVj+fmgxOoS7SqfHJ5dHo5OL0cHQ97I9P",
+	"yBVf9TBmlYt3ZUsg0x24aP7Ma7Xv2RHAlMZoQ5aWq+AbBvW1vsNWDfzhqgLLTTmF3O/r4PSaebvXjWsX",
+	"Go110FOmdO0S44n8f/Kdyd9LMPVvZ51FqJXovSaC7p8sumuVw1tcFsPjapn+e7tvmhzXxdeO5H9lmvr3",
+	"UJAttKOrqvvce63V3/3+ANryxA8EXpRooxKtNPMVClOpkB9wL76UEniovix9y/ttC2+lkrwoxYabgqIu",
+	"Y7lVU4S1O8LH3whWn5NPE0FN4rXjJrr6Sqe10t6kXw2fycV0vR3VktKDaUJr9CXJ8hiqQLGJKUeZmoqW",
+	"6L4hWwFcc40oLeJm9sMt+3ZOF3DKJhILADwTnGkh7TfDysSzrSXs8tOzpmzYEFzLhP1PQ1pLCvySfv5Q",
+	"lf7NePNyN7npbnK5u2G5yeXTZyM726jUCASmRcS9DUiQyyToBd3g7vPd/wcAvSOcmeVGAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
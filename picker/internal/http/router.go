@@ -2,8 +2,10 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/genvmoroz/win-stats/picker/internal/core"
 	openapi "github.com/genvmoroz/win-stats/picker/internal/http/generated"
@@ -11,7 +13,13 @@ import (
 )
 
 type Service interface {
-	GetStats(ctx context.Context) (core.GetStatsResponse, error)
+	GetStats(ctx context.Context, req core.GetStatsRequest) (core.GetStatsResponse, error)
+	GetHardware(ctx context.Context) ([]core.Hardware, error)
+	GetHardwareByID(ctx context.Context, id core.HardwareID) (core.Hardware, []core.Sensor, error)
+	GetSensor(ctx context.Context, id core.SensorID) (core.Sensor, error)
+	StreamStats(ctx context.Context, req core.StreamStatsRequest) (<-chan core.StatsEvent, error)
+	GetSensorHistory(ctx context.Context, req core.GetSensorHistoryRequest) ([]core.SensorHistory, error)
+	GetStatus(ctx context.Context) (core.HostStatus, error)
 }
 
 type Router struct {
@@ -31,8 +39,13 @@ func NewRouter(srv Service) (*Router, error) {
 
 var _ openapi.StrictServerInterface = (*Router)(nil)
 
-func (r *Router) GetStats(ctx context.Context, _ openapi.GetStatsRequestObject) (openapi.GetStatsResponseObject, error) {
-	stats, err := r.srv.GetStats(ctx)
+func (r *Router) GetStats(ctx context.Context, req openapi.GetStatsRequestObject) (openapi.GetStatsResponseObject, error) {
+	statsReq, err := r.transformer.GetStatsRequestFromParams(req.Params)
+	if err != nil {
+		return openapi.GetStats400JSONResponse(newAPIError(http.StatusBadRequest, err)), nil
+	}
+
+	stats, err := r.srv.GetStats(ctx, statsReq)
 	if err != nil {
 		return openapi.GetStats500JSONResponse(newAPIError(http.StatusInternalServerError, err)), nil
 	}
@@ -42,6 +55,145 @@ func (r *Router) GetStats(ctx context.Context, _ openapi.GetStatsRequestObject)
 	return openapi.GetStats200JSONResponse(resp), nil
 }
 
+func (r *Router) GetStatsV2(ctx context.Context, req openapi.GetStatsV2RequestObject) (openapi.GetStatsV2ResponseObject, error) {
+	statsReq, err := r.transformer.GetStatsRequestFromParams(openapi.GetStatsParams{
+		HardwareType: req.Params.HardwareType,
+		SensorType:   req.Params.SensorType,
+		HardwareId:   req.Params.HardwareId,
+		SensorId:     req.Params.SensorId,
+		Name:         req.Params.Name,
+		Units:        req.Params.Units,
+	})
+	if err != nil {
+		return openapi.GetStatsV2400JSONResponse(newAPIError(http.StatusBadRequest, err)), nil
+	}
+
+	stats, err := r.srv.GetStats(ctx, statsReq)
+	if err != nil {
+		return openapi.GetStatsV2500JSONResponse(newAPIError(http.StatusInternalServerError, err)), nil
+	}
+
+	resp := r.transformer.GetStatsV2ResponseFromCore(stats, lo.FromPtrOr(req.Params.Layout, openapi.Flat))
+
+	return openapi.GetStatsV2200JSONResponse(resp), nil
+}
+
+func (r *Router) StreamStats(ctx context.Context, req openapi.StreamStatsRequestObject) (openapi.StreamStatsResponseObject, error) {
+	statsReq, err := r.transformer.GetStatsRequestFromParams(openapi.GetStatsParams{
+		HardwareType: req.Params.HardwareType,
+		SensorType:   req.Params.SensorType,
+		HardwareId:   req.Params.HardwareId,
+		SensorId:     req.Params.SensorId,
+		Name:         req.Params.Name,
+		Units:        req.Params.Units,
+	})
+	if err != nil {
+		return openapi.StreamStats400JSONResponse(newAPIError(http.StatusBadRequest, err)), nil
+	}
+
+	events, err := r.srv.StreamStats(ctx, core.StreamStatsRequest{
+		GetStatsRequest: statsReq,
+		Interval:        time.Duration(lo.FromPtrOr(req.Params.Interval, 1)) * time.Second,
+		ChangesOnly:     lo.FromPtr(req.Params.Mode) == openapi.Changes,
+		LastEventID:     lo.FromPtr(req.Params.LastEventID),
+	})
+	if err != nil {
+		return openapi.StreamStats400JSONResponse(newAPIError(http.StatusBadRequest, err)), nil
+	}
+
+	return statsStreamResponse{
+		events:      events,
+		transformer: r.transformer,
+	}, nil
+}
+
+func (r *Router) GetStatsHistory(ctx context.Context, req openapi.GetStatsHistoryRequestObject) (openapi.GetStatsHistoryResponseObject, error) {
+	historyReq, err := r.transformer.GetSensorHistoryRequestFromParams(req.Params)
+	if err != nil {
+		return openapi.GetStatsHistory400JSONResponse(newAPIError(http.StatusBadRequest, err)), nil
+	}
+
+	history, err := r.srv.GetSensorHistory(ctx, historyReq)
+	switch {
+	case errors.Is(err, core.ErrHistoryDisabled):
+		return openapi.GetStatsHistory501JSONResponse(newAPIError(http.StatusNotImplemented, err)), nil
+	case errors.Is(err, core.ErrNotFound):
+		return openapi.GetStatsHistory404JSONResponse(newAPIError(http.StatusNotFound, err)), nil
+	case err != nil:
+		return openapi.GetStatsHistory500JSONResponse(newAPIError(http.StatusInternalServerError, err)), nil
+	}
+
+	return openapi.GetStatsHistory200JSONResponse(r.transformer.StatsHistoryFromCore(history)), nil
+}
+
+func (r *Router) ListHardwareV2(ctx context.Context, _ openapi.ListHardwareV2RequestObject) (openapi.ListHardwareV2ResponseObject, error) {
+	hardware, err := r.srv.GetHardware(ctx)
+	if err != nil {
+		return openapi.ListHardwareV2500JSONResponse(newAPIError(http.StatusInternalServerError, err)), nil
+	}
+
+	resp := lo.Map(hardware, func(hw core.Hardware, _ int) openapi.HardwareV2 {
+		out := r.transformer.HardwareV2FromCore(hw, nil)
+		// the listing is lightweight, the sensors are returned by /v2/hardware/{id}
+		out.Sensors = nil
+		return out
+	})
+
+	return openapi.ListHardwareV2200JSONResponse(resp), nil
+}
+
+func (r *Router) GetHardwareV2(ctx context.Context, req openapi.GetHardwareV2RequestObject) (openapi.GetHardwareV2ResponseObject, error) {
+	hw, sensors, err := r.srv.GetHardwareByID(ctx, core.HardwareID(req.Id))
+	switch {
+	case errors.Is(err, core.ErrNotFound):
+		return openapi.GetHardwareV2404JSONResponse(newAPIError(http.StatusNotFound, err)), nil
+	case err != nil:
+		return openapi.GetHardwareV2500JSONResponse(newAPIError(http.StatusInternalServerError, err)), nil
+	}
+
+	return openapi.GetHardwareV2200JSONResponse(r.transformer.HardwareV2FromCore(hw, sensors)), nil
+}
+
+func (r *Router) ListHardwareSensorsV2(
+	ctx context.Context,
+	req openapi.ListHardwareSensorsV2RequestObject,
+) (openapi.ListHardwareSensorsV2ResponseObject, error) {
+	_, sensors, err := r.srv.GetHardwareByID(ctx, core.HardwareID(req.Id))
+	switch {
+	case errors.Is(err, core.ErrNotFound):
+		return openapi.ListHardwareSensorsV2404JSONResponse(newAPIError(http.StatusNotFound, err)), nil
+	case err != nil:
+		return openapi.ListHardwareSensorsV2500JSONResponse(newAPIError(http.StatusInternalServerError, err)), nil
+	}
+
+	resp := lo.Map(sensors, func(s core.Sensor, _ int) openapi.SensorV2 {
+		return r.transformer.SensorV2FromCore(s)
+	})
+
+	return openapi.ListHardwareSensorsV2200JSONResponse(resp), nil
+}
+
+func (r *Router) GetSensorV2(ctx context.Context, req openapi.GetSensorV2RequestObject) (openapi.GetSensorV2ResponseObject, error) {
+	sensor, err := r.srv.GetSensor(ctx, core.SensorID(req.Id))
+	switch {
+	case errors.Is(err, core.ErrNotFound):
+		return openapi.GetSensorV2404JSONResponse(newAPIError(http.StatusNotFound, err)), nil
+	case err != nil:
+		return openapi.GetSensorV2500JSONResponse(newAPIError(http.StatusInternalServerError, err)), nil
+	}
+
+	return openapi.GetSensorV2200JSONResponse(r.transformer.SensorV2FromCore(sensor)), nil
+}
+
+func (r *Router) GetStatus(ctx context.Context, _ openapi.GetStatusRequestObject) (openapi.GetStatusResponseObject, error) {
+	status, err := r.srv.GetStatus(ctx)
+	if err != nil {
+		return openapi.GetStatus500JSONResponse(newAPIError(http.StatusInternalServerError, err)), nil
+	}
+
+	return openapi.GetStatus200JSONResponse(r.transformer.HostStatusFromCore(status)), nil
+}
+
 func (r *Router) HealthCheck(_ context.Context, _ openapi.HealthCheckRequestObject) (openapi.HealthCheckResponseObject, error) {
 	return openapi.HealthCheck200TextResponse("Up and running!"), nil
 }
@@ -2,35 +2,81 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"os"
 	"time"
 
+	"github.com/genvmoroz/win-stats/picker/internal/auth"
 	openapi "github.com/genvmoroz/win-stats/picker/internal/http/generated"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo-contrib/echoprometheus"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	oapimiddleware "github.com/oapi-codegen/echo-middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	metricsPath = "/metrics"
+	healthPath  = "/health"
+)
+
 type (
 	Config struct {
 		Port uint `envconfig:"APP_HTTP_SERVER_PORT" default:"8080"`
+		TLS  TLSConfig
+		// AuthTokens are the bearer tokens accepted by the server, any of them grants the access to all the endpoints
+		// except /health. Several tokens allow rotating them without downtime. The auth is off if there are none.
+		AuthTokens []string `envconfig:"APP_HTTP_SERVER_AUTH_TOKENS"`
+	}
+
+	// TLSConfig turns TLS on if the certificate is set.
+	TLSConfig struct {
+		CertFile string `envconfig:"APP_HTTP_SERVER_TLS_CERT_FILE" validate:"required_with=KeyFile"`
+		KeyFile  string `envconfig:"APP_HTTP_SERVER_TLS_KEY_FILE" validate:"required_with=CertFile"`
+		// ClientCAFile makes the server require the client certificates signed by the CA.
+		ClientCAFile string `envconfig:"APP_HTTP_SERVER_TLS_CLIENT_CA_FILE"`
 	}
 
 	Server struct {
-		cfg    Config
-		router *Router
-		echo   *echo.Echo
-		logger logrus.FieldLogger
+		cfg        Config
+		tlsConfig  *tls.Config
+		router     *Router
+		registerer prometheus.Registerer
+		gatherer   prometheus.Gatherer
+		echo       *echo.Echo
+		logger     logrus.FieldLogger
 	}
 )
 
-func NewServer(ctx context.Context, cfg Config, router *Router, logger logrus.FieldLogger) (*Server, error) {
+// NewServer creates the server, the request metrics are registered with the registerer.
+// The gatherer is served on /metrics, it should hold the sensor metrics only: the metrics of picker itself
+// are served by the admin server.
+func NewServer(
+	ctx context.Context,
+	cfg Config,
+	router *Router,
+	registerer prometheus.Registerer,
+	gatherer prometheus.Gatherer,
+	logger logrus.FieldLogger,
+) (*Server, error) {
 	if lo.IsNil(router) {
 		return nil, fmt.Errorf("router is nil")
 	}
+	if lo.IsNil(registerer) {
+		return nil, fmt.Errorf("registerer is nil")
+	}
+	if lo.IsNil(gatherer) {
+		return nil, fmt.Errorf("gatherer is nil")
+	}
 	if lo.IsNil(logger) {
 		return nil, fmt.Errorf("logger is nil")
 	}
@@ -44,11 +90,19 @@ func NewServer(ctx context.Context, cfg Config, router *Router, logger logrus.Fi
 		return nil, fmt.Errorf("can't close listener: %w", err)
 	}
 
+	tlsConfig, err := NewTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("tls config: %w", err)
+	}
+
 	server := &Server{
-		cfg:    cfg,
-		router: router,
-		echo:   echo.New(),
-		logger: logger,
+		cfg:        cfg,
+		tlsConfig:  tlsConfig,
+		router:     router,
+		registerer: registerer,
+		gatherer:   gatherer,
+		echo:       echo.New(),
+		logger:     logger,
 	}
 
 	if err = server.register(); err != nil {
@@ -61,10 +115,21 @@ func NewServer(ctx context.Context, cfg Config, router *Router, logger logrus.Fi
 // Run starts the server and listens for incoming requests.
 // The server will be stopped when the context is canceled.
 func (s *Server) Run(ctx context.Context) error {
+	server := s.echo.Server
+	if s.tlsConfig != nil {
+		server = s.echo.TLSServer
+		server.TLSConfig = s.tlsConfig
+	}
+	server.Addr = fmt.Sprintf(":%d", s.cfg.Port)
+	// the requests are canceled together with the server, otherwise the open streams would hold the shutdown
+	server.BaseContext = func(net.Listener) context.Context {
+		return ctx
+	}
+
 	errChan := make(chan error, 1)
 	go func(ch chan error) {
 		s.logger.Debug("starting http server")
-		ch <- s.echo.Start(fmt.Sprintf(":%d", s.cfg.Port))
+		ch <- s.echo.StartServer(server)
 	}(errChan)
 
 	select {
@@ -94,8 +159,83 @@ func (s *Server) register() error {
 	if err != nil {
 		return fmt.Errorf("get swagger: %w", err)
 	}
-	s.echo.Use(oapimiddleware.OapiRequestValidator(swagger))
+	metrics, err := echoprometheus.MiddlewareConfig{
+		Namespace: "picker",
+		Subsystem: "http",
+		Skipper: func(c echo.Context) bool {
+			return c.Path() == metricsPath
+		},
+		// the paths of the unknown routes would make a series each
+		DoNotUseRequestPathFor404: true,
+		Registerer:                s.registerer,
+	}.ToMiddleware()
+	if err != nil {
+		return fmt.Errorf("request metrics: %w", err)
+	}
+	s.echo.Use(metrics)
 	s.echo.Use(middleware.Logger())
+	if len(s.cfg.AuthTokens) > 0 {
+		// the auth goes before the validation, so the API details are not disclosed to the unauthorized clients
+		s.echo.Use(middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
+			Skipper: func(c echo.Context) bool {
+				return c.Path() == healthPath
+			},
+			Validator: func(token string, _ echo.Context) (bool, error) {
+				return auth.ValidToken(token, s.cfg.AuthTokens), nil
+			},
+			ErrorHandler: func(err error, c echo.Context) error {
+				return c.JSON(http.StatusUnauthorized, newAPIError(http.StatusUnauthorized, err))
+			},
+		}))
+	}
+	s.echo.Use(oapimiddleware.OapiRequestValidatorWithOptions(swagger, &oapimiddleware.Options{
+		// the metrics endpoint is not a part of the API, it is served by the Prometheus handler
+		Skipper: func(c echo.Context) bool {
+			return c.Path() == metricsPath
+		},
+		Options: openapi3filter.Options{
+			// the bearer token is checked by the auth middleware
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+	}))
+
+	s.echo.GET(metricsPath, echo.WrapHandler(promhttp.HandlerFor(s.gatherer, promhttp.HandlerOpts{})))
 
 	return nil
 }
+
+// NewTLSConfig loads the certificates, it returns nil if TLS is off.
+// The gRPC server is secured by the same config, so it is not a way around the one of the HTTP server.
+func NewTLSConfig(cfg TLSConfig) (*tls.Config, error) {
+	if cfg.CertFile == "" {
+		if cfg.ClientCAFile != "" {
+			return nil, errors.New("client CA requires the server certificate")
+		}
+		return nil, nil //nolint:nilnil // TLS is off
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("client CA has no certificates")
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
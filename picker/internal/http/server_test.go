@@ -0,0 +1,470 @@
+package http_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	pickerhttp "github.com/genvmoroz/win-stats/picker/internal/http"
+	"github.com/genvmoroz/win-stats/picker/internal/testutils"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerAuth(t *testing.T) {
+	t.Parallel()
+
+	base := runServer(t, pickerhttp.Config{AuthTokens: []string{"old", "new"}}, http.DefaultClient, "http")
+
+	tests := map[string]struct {
+		path       string
+		header     string
+		wantStatus int
+	}{
+		"health is open":  {path: "/health", wantStatus: http.StatusOK},
+		"no token":        {path: "/v2/hardware", wantStatus: http.StatusUnauthorized},
+		"wrong token":     {path: "/v2/hardware", header: "Bearer wrong", wantStatus: http.StatusUnauthorized},
+		"wrong scheme":    {path: "/v2/hardware", header: "Basic new", wantStatus: http.StatusUnauthorized},
+		"token":           {path: "/v2/hardware", header: "Bearer new", wantStatus: http.StatusOK},
+		"rotated token":   {path: "/v2/hardware", header: "Bearer old", wantStatus: http.StatusOK},
+		"metrics no auth": {path: "/metrics", wantStatus: http.StatusUnauthorized},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, base+test.path, nil)
+			require.NoError(t, err)
+			if test.header != "" {
+				req.Header.Set("Authorization", test.header)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			require.Equal(t, test.wantStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestServerTLS(t *testing.T) {
+	t.Parallel()
+
+	var (
+		dir        = t.TempDir()
+		ca         = newCA(t)
+		serverCert = ca.issue(t, dir, "server", x509.ExtKeyUsageServerAuth)
+		clientCert = ca.issue(t, dir, "client", x509.ExtKeyUsageClientAuth)
+		caFile     = filepath.Join(dir, "ca.pem")
+	)
+	require.NoError(t, os.WriteFile(caFile, ca.pem, 0o600))
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(ca.pem)
+	newClient := func(certs ...tls.Certificate) *http.Client {
+		return &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: certs, MinVersion: tls.VersionTLS12},
+		}}
+	}
+
+	cfg := pickerhttp.Config{TLS: pickerhttp.TLSConfig{
+		CertFile:     serverCert.certFile,
+		KeyFile:      serverCert.keyFile,
+		ClientCAFile: caFile,
+	}}
+	base := runServer(t, cfg, newClient(clientCert.cert), "https")
+
+	resp, err := newClient(clientCert.cert).Get(base + "/v2/hardware")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// the client without a certificate is rejected during the handshake
+	_, err = newClient().Get(base + "/health") //nolint:bodyclose // the request fails
+	require.Error(t, err)
+}
+
+func TestServerEncodedIDs(t *testing.T) {
+	t.Parallel()
+
+	base := runServer(t, pickerhttp.Config{}, http.DefaultClient, "http")
+
+	tests := map[string]struct {
+		path       string
+		wantStatus int
+		wantID     string
+	}{
+		"hardware": {
+			path:       "/v2/hardware/%2Fintelcpu%2F0",
+			wantStatus: http.StatusOK,
+			wantID:     "/intelcpu/0",
+		},
+		"hardware sensors": {
+			path:       "/v2/hardware/%2Fintelcpu%2F0/sensors",
+			wantStatus: http.StatusOK,
+		},
+		"sensor": {
+			path:       "/v2/sensors/%2Fintelcpu%2F0%2Fload%2F0",
+			wantStatus: http.StatusOK,
+			wantID:     "/intelcpu/0/load/0",
+		},
+		"unknown hardware": {
+			path:       "/v2/hardware/%2Fintelcpu%2F1",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := http.Get(base + test.path) //nolint:noctx // the test server is local
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, resp.Body.Close())
+			}()
+			require.Equal(t, test.wantStatus, resp.StatusCode)
+
+			if test.wantID != "" {
+				var body struct {
+					ID string
+				}
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+				require.Equal(t, test.wantID, body.ID)
+			}
+		})
+	}
+}
+
+func TestServerMetrics(t *testing.T) {
+	t.Parallel()
+
+	base := runServer(t, pickerhttp.Config{}, http.DefaultClient, "http")
+
+	resp, err := http.Get(base + "/metrics") //nolint:noctx // the test server is local
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "sensor_value")
+	require.NotContains(t, string(body), "go_goroutines")
+	require.NotContains(t, string(body), "picker_http_requests_total")
+}
+
+func TestServerStatsV1Units(t *testing.T) {
+	t.Parallel()
+
+	base := runServer(t, pickerhttp.Config{}, http.DefaultClient, "http")
+
+	tests := map[string]struct {
+		query     string
+		wantValue int64
+		wantUnit  string
+	}{
+		"no units":   {query: "", wantValue: 21, wantUnit: core.Celsius.String()},
+		"fahrenheit": {query: "?units=fahrenheit", wantValue: 71, wantUnit: core.Fahrenheit.String()},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := http.Get(base + "/stats" + test.query) //nolint:noctx // the test server is local
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, resp.Body.Close())
+			}()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var body struct {
+				Hardware []struct {
+					Sensors []struct {
+						Unit  string
+						Value struct {
+							Value int64
+						}
+					}
+				}
+			}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			require.Len(t, body.Hardware, 1)
+			require.Len(t, body.Hardware[0].Sensors, 1)
+			// 21.4 °C is 70.52 °F, the value is rounded after the conversion
+			require.Equal(t, test.wantValue, body.Hardware[0].Sensors[0].Value.Value)
+			require.Equal(t, test.wantUnit, body.Hardware[0].Sensors[0].Unit)
+		})
+	}
+}
+
+func TestServerStream(t *testing.T) {
+	t.Parallel()
+
+	base := runServer(t, pickerhttp.Config{}, http.DefaultClient, "http")
+
+	tests := map[string]struct {
+		query       string
+		lastEventID string
+		wantID      string
+		wantKind    string
+	}{
+		"snapshot": {query: "", wantID: "1-1", wantKind: "snapshot"},
+		"resumed":  {query: "?mode=changes", lastEventID: "1-1", wantID: "1-2", wantKind: "changes"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, base+"/stats/stream"+test.query, nil)
+			require.NoError(t, err)
+			if test.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", test.lastEventID)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, resp.Body.Close())
+			}()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+			// the fake service sends a single event and ends the stream
+			raw, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			event, ok := strings.CutSuffix(string(raw), "\n\n")
+			require.True(t, ok, "event is not terminated by a blank line: %q", raw)
+
+			lines := strings.Split(event, "\n")
+			require.Len(t, lines, 3)
+			require.Equal(t, "id: "+test.wantID, lines[0])
+			require.Equal(t, "event: "+test.wantKind, lines[1])
+
+			data, ok := strings.CutPrefix(lines[2], "data: ")
+			require.True(t, ok, "no data field: %q", lines[2])
+			var body struct {
+				Hardware []struct {
+					ID      string
+					Sensors []struct {
+						ID string
+					}
+				}
+			}
+			require.NoError(t, json.Unmarshal([]byte(data), &body))
+			require.Len(t, body.Hardware, 1)
+			require.Equal(t, string(fakeCPU.ID), body.Hardware[0].ID)
+			require.Len(t, body.Hardware[0].Sensors, 1)
+			require.Equal(t, string(fakeTemperature.ID), body.Hardware[0].Sensors[0].ID)
+		})
+	}
+}
+
+func TestNewServerTLSInvalid(t *testing.T) {
+	t.Parallel()
+
+	router, err := pickerhttp.NewRouter(fakeService{})
+	require.NoError(t, err)
+
+	_, err = pickerhttp.NewServer(context.Background(), pickerhttp.Config{
+		Port: testutils.FreePort(t),
+		TLS:  pickerhttp.TLSConfig{ClientCAFile: "ca.pem"},
+	}, router, prometheus.NewRegistry(), prometheus.NewRegistry(), logrus.New())
+	require.ErrorContains(t, err, "client CA requires the server certificate")
+
+	_, err = pickerhttp.NewServer(context.Background(), pickerhttp.Config{
+		Port: testutils.FreePort(t),
+		TLS:  pickerhttp.TLSConfig{CertFile: "missing.pem", KeyFile: "missing.key"},
+	}, router, prometheus.NewRegistry(), prometheus.NewRegistry(), logrus.New())
+	require.ErrorContains(t, err, "load certificate")
+}
+
+// runServer starts the server and waits until its /health is served, it returns the base URL.
+func runServer(t *testing.T, cfg pickerhttp.Config, client *http.Client, scheme string) string {
+	t.Helper()
+
+	router, err := pickerhttp.NewRouter(fakeService{})
+	require.NoError(t, err)
+
+	// the API serves only the sensor metrics, the request ones are registered apart
+	sensors := prometheus.NewRegistry()
+	sensors.MustRegister(prometheus.NewGauge(prometheus.GaugeOpts{Name: "sensor_value", Help: "Sensor value"}))
+
+	cfg.Port = testutils.FreePort(t)
+	server, err := pickerhttp.NewServer(context.Background(), cfg, router, prometheus.NewRegistry(), sensors, logrus.New())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- server.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	base := fmt.Sprintf("%s://localhost:%d", scheme, cfg.Port)
+	require.Eventually(t, func() bool {
+		resp, err := client.Get(base + "/health")
+		if err != nil {
+			return false
+		}
+		_ = resp.Body.Close()
+		return resp.StatusCode == http.StatusOK
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return base
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+type issuedCert struct {
+	cert     tls.Certificate
+	certFile string
+	keyFile  string
+}
+
+func newCA(t *testing.T) testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func (ca testCA) issue(t *testing.T, dir, name string, usage x509.ExtKeyUsage) issuedCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	var (
+		certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+		keyPEM  = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+		out     = issuedCert{
+			certFile: filepath.Join(dir, name+".pem"),
+			keyFile:  filepath.Join(dir, name+".key"),
+		}
+	)
+	require.NoError(t, os.WriteFile(out.certFile, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(out.keyFile, keyPEM, 0o600))
+	out.cert, err = tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+
+	return out
+}
+
+// fakeService serves the single CPU with the single sensor, the stats are of its temperature sensor.
+// The other methods are not called by the tests.
+type fakeService struct {
+	pickerhttp.Service
+}
+
+var (
+	fakeCPU         = core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+	fakeSensor      = core.Sensor{ID: "/intelcpu/0/load/0", HardwareID: fakeCPU.ID, Type: core.Load}
+	fakeTemperature = core.Sensor{
+		ID: "/intelcpu/0/temperature/0", HardwareID: fakeCPU.ID, Type: core.Temperature,
+		Value: core.SensorValue{Value: 21.4},
+	}
+)
+
+// StreamStats sends the single event and ends the stream: the snapshot, or the changes
+// if the changes stream is resumed from the event 1-1.
+func (fakeService) StreamStats(_ context.Context, req core.StreamStatsRequest) (<-chan core.StatsEvent, error) {
+	event := core.StatsEvent{ID: "1-1", Kind: core.SnapshotEvent}
+	if req.ChangesOnly && req.LastEventID == "1-1" {
+		event = core.StatsEvent{ID: "1-2", Kind: core.ChangesEvent}
+	}
+	event.Stats = map[core.Hardware][]core.Sensor{fakeCPU: {fakeTemperature}}
+
+	events := make(chan core.StatsEvent, 1)
+	events <- event
+	close(events)
+
+	return events, nil
+}
+
+// GetStats converts the values like the core service does.
+func (fakeService) GetStats(_ context.Context, req core.GetStatsRequest) (core.GetStatsResponse, error) {
+	return core.GetStatsResponse{
+		Stats: map[core.Hardware][]core.Sensor{
+			fakeCPU: {req.Units.Convert(fakeTemperature)},
+		},
+	}, nil
+}
+
+func (fakeService) GetHardware(_ context.Context) ([]core.Hardware, error) {
+	return []core.Hardware{fakeCPU}, nil
+}
+
+func (fakeService) GetHardwareByID(_ context.Context, id core.HardwareID) (core.Hardware, []core.Sensor, error) {
+	if id != fakeCPU.ID {
+		return core.Hardware{}, nil, fmt.Errorf("hardware %s: %w", id, core.ErrNotFound)
+	}
+
+	return fakeCPU, []core.Sensor{fakeSensor}, nil
+}
+
+func (fakeService) GetSensor(_ context.Context, id core.SensorID) (core.Sensor, error) {
+	if id != fakeSensor.ID {
+		return core.Sensor{}, fmt.Errorf("sensor %s: %w", id, core.ErrNotFound)
+	}
+
+	return fakeSensor, nil
+}
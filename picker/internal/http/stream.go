@@ -0,0 +1,47 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	openapi "github.com/genvmoroz/win-stats/picker/internal/http/generated"
+)
+
+// statsStreamResponse writes the stats events as Server-Sent Events until the events channel is closed.
+// The generated text/event-stream response copies a single body, it can't flush every event.
+type statsStreamResponse struct {
+	events      <-chan core.StatsEvent
+	transformer Transformer
+}
+
+var _ openapi.StreamStatsResponseObject = statsStreamResponse{}
+
+func (r statsStreamResponse) VisitStreamStatsResponse(w http.ResponseWriter) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("response writer %T doesn't support flushing", w)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// disables the response buffering of nginx, otherwise the events are delayed behind a reverse proxy
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for event := range r.events {
+		data, err := json.Marshal(r.transformer.StatsEventDataFromCore(event))
+		if err != nil {
+			return fmt.Errorf("marshal event %s: %w", event.ID, err)
+		}
+		if _, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Kind, data); err != nil {
+			return fmt.Errorf("write event %s: %w", event.ID, err)
+		}
+		flusher.Flush()
+	}
+
+	return nil
+}
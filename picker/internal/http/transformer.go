@@ -1,6 +1,14 @@
 package http
 
 import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"time"
+
 	"github.com/genvmoroz/win-stats/picker/internal/core"
 	openapi "github.com/genvmoroz/win-stats/picker/internal/http/generated"
 	"github.com/samber/lo"
@@ -8,8 +16,11 @@ import (
 
 type Transformer struct{}
 
+// GetStatsResponseFromCore builds the deprecated v1 response, the values are rounded to integers.
 func (t Transformer) GetStatsResponseFromCore(in core.GetStatsResponse) openapi.Stats {
-	out := openapi.Stats{}
+	out := openapi.Stats{
+		Warnings: lo.EmptyableToPtr(in.Warnings),
+	}
 	if in.Stats == nil {
 		return out
 	}
@@ -36,26 +47,300 @@ func (t Transformer) GetStatsResponseFromCore(in core.GetStatsResponse) openapi.
 	return out
 }
 
+func (t Transformer) GetStatsV2ResponseFromCore(in core.GetStatsResponse, layout openapi.GetStatsV2ParamsLayout) openapi.StatsV2 {
+	out := openapi.StatsV2{
+		Warnings: lo.EmptyableToPtr(in.Warnings),
+	}
+	if in.Freshness != nil {
+		out.Stale = lo.ToPtr(in.Freshness.Stale)
+		out.Age = lo.ToPtr(in.Freshness.Age.Seconds())
+	}
+	if in.Stats == nil {
+		return out
+	}
+
+	if layout == openapi.Tree {
+		out.Hardware = lo.ToPtr(t.hardwareTreeV2FromCore(in.Stats))
+		return out
+	}
+
+	hardware := make([]openapi.HardwareV2, 0, len(in.Stats))
+	for coreHW, coreSensors := range in.Stats {
+		if len(coreSensors) == 0 {
+			continue
+		}
+		hardware = append(hardware, t.HardwareV2FromCore(coreHW, coreSensors))
+	}
+
+	out.Hardware = &hardware
+
+	return out
+}
+
+// StatsEventDataFromCore returns the data of the stream event: the Error for the error event, the StatsV2 otherwise.
+func (t Transformer) StatsEventDataFromCore(in core.StatsEvent) any {
+	if in.Kind == core.ErrorEvent {
+		return newAPIError(http.StatusInternalServerError, in.Err)
+	}
+
+	return t.GetStatsV2ResponseFromCore(
+		core.GetStatsResponse{
+			Stats:    in.Stats,
+			Warnings: in.Warnings,
+		},
+		openapi.Flat,
+	)
+}
+
+// hardwareTreeV2FromCore nests the hardware under its parent. The hardware without sensors is kept
+// only if it has the nested hardware with ones. The hardware whose parent is unknown becomes top-level.
+func (t Transformer) hardwareTreeV2FromCore(in map[core.Hardware][]core.Sensor) []openapi.HardwareV2 {
+	all := lo.Keys(in)
+	slices.SortFunc(all, func(a, b core.Hardware) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	known := make(map[core.HardwareID]struct{}, len(all))
+	for _, hw := range all {
+		known[hw.ID] = struct{}{}
+	}
+
+	var (
+		roots    []core.Hardware
+		children = make(map[core.HardwareID][]core.Hardware)
+	)
+	for _, hw := range all {
+		if _, ok := known[hw.ParentID]; ok && hw.ParentID != hw.ID {
+			children[hw.ParentID] = append(children[hw.ParentID], hw)
+			continue
+		}
+		roots = append(roots, hw)
+	}
+
+	visited := make(map[core.HardwareID]struct{}, len(all))
+
+	var build func(hw core.Hardware) (openapi.HardwareV2, bool)
+	build = func(hw core.Hardware) (openapi.HardwareV2, bool) {
+		visited[hw.ID] = struct{}{}
+
+		var nested []openapi.HardwareV2
+		for _, child := range children[hw.ID] {
+			if _, ok := visited[child.ID]; ok {
+				continue
+			}
+			if node, ok := build(child); ok {
+				nested = append(nested, node)
+			}
+		}
+
+		if len(in[hw]) == 0 && len(nested) == 0 {
+			return openapi.HardwareV2{}, false
+		}
+
+		node := t.HardwareV2FromCore(hw, in[hw])
+		if len(nested) > 0 {
+			node.Children = &nested
+		}
+
+		return node, true
+	}
+
+	out := make([]openapi.HardwareV2, 0, len(roots))
+	// the hardware referencing each other in a loop has no root, it is returned as top-level
+	for _, hw := range append(roots, all...) {
+		if _, ok := visited[hw.ID]; ok {
+			continue
+		}
+		if node, ok := build(hw); ok {
+			out = append(out, node)
+		}
+	}
+
+	return out
+}
+
 func (t Transformer) hardwareFromCore(in core.Hardware) openapi.Hardware {
 	return openapi.Hardware{
-		ID:   lo.ToPtr(string(in.ID)),
-		Name: lo.ToPtr(in.Name),
-		Type: lo.ToPtr(in.Type.String()),
+		ID:      lo.ToPtr(string(in.ID)),
+		Name:    lo.ToPtr(in.Name),
+		Type:    lo.ToPtr(in.Type.String()),
+		Subtype: lo.EmptyableToPtr(in.Subtype),
 	}
 }
 
 func (t Transformer) sensorFromCore(in core.Sensor) openapi.Sensor {
 	return openapi.Sensor{
-		ID:    lo.ToPtr(string(in.ID)),
-		Name:  lo.ToPtr(in.Name),
-		Type:  lo.ToPtr(in.Type.String()),
-		Value: lo.ToPtr(t.valueFromCore(in.Value)),
+		ID:      lo.ToPtr(string(in.ID)),
+		Name:    lo.ToPtr(in.Name),
+		Type:    lo.ToPtr(in.Type.String()),
+		RawType: lo.EmptyableToPtr(in.RawType),
+		Index:   lo.ToPtr(in.Index),
+		Unit:    lo.ToPtr(in.Unit.String()),
+		Status:  lo.ToPtr(openapi.HealthStatus(in.Status.String())),
+		Value:   lo.ToPtr(t.valueFromCore(in.Value)),
 	}
 }
 
 func (t Transformer) valueFromCore(in core.SensorValue) openapi.SensorValue {
 	return openapi.SensorValue{
+		Value:     lo.ToPtr(int64(math.Round(in.Value))),
+		Min:       lo.ToPtr(int64(math.Round(in.Min))),
+		Max:       lo.ToPtr(int64(math.Round(in.Max))),
+		Timestamp: lo.ToPtr(in.Timestamp.Unix()),
+	}
+}
+
+func (t Transformer) HardwareV2FromCore(in core.Hardware, coreSensors []core.Sensor) openapi.HardwareV2 {
+	sensors := make([]openapi.SensorV2, len(coreSensors))
+	for idx, coreSensor := range coreSensors {
+		sensors[idx] = t.SensorV2FromCore(coreSensor)
+	}
+
+	return openapi.HardwareV2{
+		ID:       lo.ToPtr(string(in.ID)),
+		ParentID: lo.EmptyableToPtr(string(in.ParentID)),
+		Name:     lo.ToPtr(in.Name),
+		Type:     lo.ToPtr(in.Type.String()),
+		RawType:  lo.EmptyableToPtr(in.RawType),
+		Subtype:  lo.EmptyableToPtr(in.Subtype),
+		Sensors:  &sensors,
+	}
+}
+
+func (t Transformer) SensorV2FromCore(in core.Sensor) openapi.SensorV2 {
+	return openapi.SensorV2{
+		ID:         lo.ToPtr(string(in.ID)),
+		HardwareID: lo.ToPtr(string(in.HardwareID)),
+		Name:       lo.ToPtr(in.Name),
+		Type:       lo.ToPtr(in.Type.String()),
+		RawType:    lo.EmptyableToPtr(in.RawType),
+		Index:      lo.ToPtr(in.Index),
+		Unit:       lo.ToPtr(in.Unit.String()),
+		Status:     lo.ToPtr(openapi.HealthStatus(in.Status.String())),
+		Value:      lo.ToPtr(t.valueV2FromCore(in.Value)),
+	}
+}
+
+func (t Transformer) valueV2FromCore(in core.SensorValue) openapi.SensorValueV2 {
+	return openapi.SensorValueV2{
 		Value:     lo.ToPtr(in.Value),
+		Min:       lo.ToPtr(in.Min),
+		Max:       lo.ToPtr(in.Max),
 		Timestamp: lo.ToPtr(in.Timestamp.Unix()),
 	}
 }
+
+// GetStatsRequestFromParams parses the filter and units query parameters,
+// the unknown type and unit preference names are rejected.
+func (t Transformer) GetStatsRequestFromParams(in openapi.GetStatsParams) (core.GetStatsRequest, error) {
+	var errs []error
+
+	hardwareTypes := lo.Map(lo.FromPtr(in.HardwareType), func(name string, _ int) core.HardwareType {
+		hwType, err := core.ParseHardwareType(name)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return hwType
+	})
+	sensorTypes := lo.Map(lo.FromPtr(in.SensorType), func(name string, _ int) core.SensorType {
+		sensorType, err := core.ParseSensorType(name)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return sensorType
+	})
+	units, err := core.ParseUnitPreferences(lo.FromPtr(in.Units))
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return core.GetStatsRequest{}, errors.Join(errs...)
+	}
+
+	return core.GetStatsRequest{
+		Filter: core.StatsFilter{
+			HardwareTypes: hardwareTypes,
+			SensorTypes:   sensorTypes,
+			HardwareIDs: lo.Map(lo.FromPtr(in.HardwareId), func(id string, _ int) core.HardwareID {
+				return core.HardwareID(id)
+			}),
+			SensorIDs: lo.Map(lo.FromPtr(in.SensorId), func(id string, _ int) core.SensorID {
+				return core.SensorID(id)
+			}),
+			NamePatterns: lo.FromPtr(in.Name),
+		},
+		Units: units,
+	}, nil
+}
+
+// GetSensorHistoryRequestFromParams parses the range as a duration, the negative one is rejected.
+func (t Transformer) GetSensorHistoryRequestFromParams(in openapi.GetStatsHistoryParams) (core.GetSensorHistoryRequest, error) {
+	var errs []error
+
+	var window time.Duration
+	if in.Range != nil {
+		var err error
+		window, err = time.ParseDuration(*in.Range)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("parse range: %w", err))
+		case window < 0:
+			errs = append(errs, fmt.Errorf("range must not be negative: %s", *in.Range))
+		}
+	}
+	units, err := core.ParseUnitPreferences(lo.FromPtr(in.Units))
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return core.GetSensorHistoryRequest{}, errors.Join(errs...)
+	}
+
+	return core.GetSensorHistoryRequest{
+		SensorIDs: lo.Map(in.SensorId, func(id string, _ int) core.SensorID {
+			return core.SensorID(id)
+		}),
+		Range: window,
+		Units: units,
+	}, nil
+}
+
+func (t Transformer) StatsHistoryFromCore(in []core.SensorHistory) openapi.StatsHistory {
+	sensors := lo.Map(in, func(history core.SensorHistory, _ int) openapi.SensorHistory {
+		values := lo.Map(history.Values, func(value core.SensorValue, _ int) openapi.SensorValueV2 {
+			return t.valueV2FromCore(value)
+		})
+
+		return openapi.SensorHistory{
+			Sensor: lo.ToPtr(t.SensorV2FromCore(history.Sensor)),
+			Values: &values,
+		}
+	})
+
+	return openapi.StatsHistory{Sensors: &sensors}
+}
+
+func (t Transformer) HostStatusFromCore(in core.HostStatus) openapi.HostStatus {
+	hardware := lo.Map(in.Hardware, func(hw core.HardwareStatus, _ int) openapi.HardwareStatus {
+		sensors := lo.Map(hw.Sensors, func(sensor core.SensorStatus, _ int) openapi.SensorStatus {
+			return openapi.SensorStatus{
+				Sensor: lo.ToPtr(t.SensorV2FromCore(sensor.Sensor)),
+				Status: lo.ToPtr(openapi.HealthStatus(sensor.Status.String())),
+				Reason: lo.EmptyableToPtr(sensor.Reason),
+			}
+		})
+
+		return openapi.HardwareStatus{
+			ID:      lo.ToPtr(string(hw.Hardware.ID)),
+			Name:    lo.ToPtr(hw.Hardware.Name),
+			Type:    lo.ToPtr(hw.Hardware.Type.String()),
+			Status:  lo.ToPtr(openapi.HealthStatus(hw.Status.String())),
+			Sensors: &sensors,
+		}
+	})
+
+	return openapi.HostStatus{
+		Status:   lo.ToPtr(openapi.HealthStatus(in.Status.String())),
+		Hardware: &hardware,
+	}
+}
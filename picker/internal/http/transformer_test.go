@@ -0,0 +1,63 @@
+package http_test
+
+import (
+	"testing"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	pickerhttp "github.com/genvmoroz/win-stats/picker/internal/http"
+	openapi "github.com/genvmoroz/win-stats/picker/internal/http/generated"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransformerGetStatsV2ResponseFromCoreTree(t *testing.T) {
+	t.Parallel()
+
+	var (
+		board   = core.Hardware{ID: "/motherboard", Name: "Board", Type: core.Motherboard}
+		superIO = core.Hardware{ID: "/lpc/nct6798d", ParentID: board.ID, Name: "SuperIO", Type: core.SuperIO}
+		cpu     = core.Hardware{ID: "/intelcpu/0", Name: "CPU", Type: core.CPU}
+		orphan  = core.Hardware{ID: "/gpu/0/memory", ParentID: "/gpu/0", Name: "Memory", Type: core.Memory}
+		empty   = core.Hardware{ID: "/battery", Name: "Battery", Type: core.Battery}
+		loopA   = core.Hardware{ID: "/loop/a", ParentID: "/loop/b", Name: "A", Type: core.HDD}
+		loopB   = core.Hardware{ID: "/loop/b", ParentID: "/loop/a", Name: "B", Type: core.HDD}
+	)
+
+	sensor := func(hw core.Hardware) core.Sensor {
+		return core.Sensor{ID: core.SensorID(hw.ID + "/load/0"), HardwareID: hw.ID, Name: "Load", Type: core.Load}
+	}
+
+	resp := pickerhttp.Transformer{}.GetStatsV2ResponseFromCore(
+		core.GetStatsResponse{
+			Stats: map[core.Hardware][]core.Sensor{
+				board:   nil,
+				superIO: {sensor(superIO)},
+				cpu:     {sensor(cpu)},
+				orphan:  {sensor(orphan)},
+				empty:   nil,
+				loopA:   {sensor(loopA)},
+				loopB:   {sensor(loopB)},
+			},
+		},
+		openapi.Tree,
+	)
+
+	type node struct {
+		ID       string
+		Children []node
+	}
+	var toNodes func(in []openapi.HardwareV2) []node
+	toNodes = func(in []openapi.HardwareV2) []node {
+		return lo.Map(in, func(hw openapi.HardwareV2, _ int) node {
+			return node{ID: lo.FromPtr(hw.ID), Children: toNodes(lo.FromPtr(hw.Children))}
+		})
+	}
+
+	want := []node{
+		{ID: "/gpu/0/memory", Children: []node{}},
+		{ID: "/intelcpu/0", Children: []node{}},
+		{ID: "/motherboard", Children: []node{{ID: "/lpc/nct6798d", Children: []node{}}}},
+		{ID: "/loop/a", Children: []node{{ID: "/loop/b", Children: []node{}}}},
+	}
+	require.Equal(t, want, toNodes(lo.FromPtr(resp.Hardware)))
+}
@@ -0,0 +1,227 @@
+// Package history samples the stats in the background and keeps the recent values of every sensor,
+// so a client that was offline for a while can fill the gap.
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+type (
+	// Config caps the memory taken by the history: a value takes about 48 bytes,
+	// so the history takes up to Capacity * MaxSensors * 48 bytes, about 35MB by default.
+	Config struct {
+		// Enabled turns the background sampling on, the history is not available otherwise.
+		Enabled bool `envconfig:"APP_HISTORY_ENABLED" default:"false"`
+		// SampleInterval is how often the stats are read into the history.
+		SampleInterval time.Duration `envconfig:"APP_HISTORY_SAMPLE_INTERVAL" default:"5s" validate:"gt=0"`
+		// Capacity is the number of the values kept per sensor, the oldest ones are overwritten.
+		Capacity int `envconfig:"APP_HISTORY_CAPACITY" default:"720" validate:"gt=0"`
+		// MaxSensors is the number of the sensors recorded, the sensors that appear after it is reached are skipped.
+		// The sensor that is not read for Capacity * SampleInterval is dropped, so it doesn't hold the place forever.
+		MaxSensors int `envconfig:"APP_HISTORY_MAX_SENSORS" default:"1000" validate:"gt=0"`
+	}
+
+	TimeGenerator interface {
+		Now() time.Time
+	}
+
+	Repo struct {
+		statsRepo core.StatsRepo
+		timegen   TimeGenerator
+		cfg       Config
+		logger    logrus.FieldLogger
+
+		mux     *sync.RWMutex
+		buffers map[core.SensorID]*ring
+		// capped is set once the sensors are skipped because of MaxSensors, to log it once
+		capped bool
+	}
+)
+
+func NewRepo(statsRepo core.StatsRepo, timegen TimeGenerator, cfg Config, logger logrus.FieldLogger) (*Repo, error) {
+	if lo.IsNil(statsRepo) {
+		return nil, errors.New("stats repo is nil")
+	}
+	if lo.IsNil(timegen) {
+		return nil, errors.New("time generator is nil")
+	}
+	if lo.IsNil(logger) {
+		return nil, errors.New("logger is nil")
+	}
+	if cfg.Enabled {
+		if cfg.SampleInterval <= 0 {
+			return nil, errors.New("sample interval must be positive")
+		}
+		if cfg.Capacity <= 0 {
+			return nil, errors.New("capacity must be positive")
+		}
+		if cfg.MaxSensors <= 0 {
+			return nil, errors.New("max sensors must be positive")
+		}
+	}
+
+	return &Repo{
+		statsRepo: statsRepo,
+		timegen:   timegen,
+		cfg:       cfg,
+		logger:    logger,
+		mux:       &sync.RWMutex{},
+		buffers:   make(map[core.SensorID]*ring),
+	}, nil
+}
+
+// Run samples the stats until the context is canceled, it returns at once if the history is disabled.
+func (r *Repo) Run(ctx context.Context) error {
+	if !r.cfg.Enabled {
+		return nil
+	}
+
+	ticker := time.NewTicker(r.cfg.SampleInterval)
+	defer ticker.Stop()
+
+	r.sample(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			r.sample(ctx)
+		}
+	}
+}
+
+// sample records the current values, a failed read leaves a gap in the history.
+func (r *Repo) sample(ctx context.Context) {
+	sensorsByHardware, err := r.statsRepo.GetSensorsByHardware(ctx, core.StatsFilter{})
+	if err != nil {
+		if ctx.Err() == nil {
+			r.logger.Warnf("sample history: get sensors: %s", err)
+		}
+		return
+	}
+
+	now := r.timegen.Now()
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.evict(now)
+	for _, sensors := range sensorsByHardware {
+		for _, sensor := range sensors {
+			buf, ok := r.buffers[sensor.ID]
+			if !ok {
+				if len(r.buffers) >= r.cfg.MaxSensors {
+					if !r.capped {
+						r.capped = true
+						r.logger.Warnf("history is limited to %d sensors, the new sensors are not recorded", r.cfg.MaxSensors)
+					}
+					continue
+				}
+				buf = newRing(r.cfg.Capacity)
+				r.buffers[sensor.ID] = buf
+			}
+			buf.push(sensor, now)
+		}
+	}
+}
+
+// evict drops the buffers of the sensors that are gone, e.g. the unplugged drive: all their values are older
+// than the history would keep anyway. The caller holds the mutex.
+func (r *Repo) evict(now time.Time) {
+	window := time.Duration(r.cfg.Capacity) * r.cfg.SampleInterval
+	for id, buf := range r.buffers {
+		if now.Sub(buf.sampledAt) > window {
+			delete(r.buffers, id)
+			// the place is freed, so the next time the limit is reached it is logged again
+			r.capped = false
+		}
+	}
+}
+
+// GetSensorHistory returns the latest known state of the sensor and its values recorded within the window
+// ending now, oldest first. The zero window returns all the recorded values.
+func (r *Repo) GetSensorHistory(_ context.Context, id core.SensorID, window time.Duration) (core.Sensor, []core.SensorValue, error) {
+	if !r.cfg.Enabled {
+		return core.Sensor{}, nil, core.ErrHistoryDisabled
+	}
+
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	buf, ok := r.buffers[id]
+	if !ok {
+		return core.Sensor{}, nil, fmt.Errorf("sensor %s: %w", id, core.ErrNotFound)
+	}
+
+	var since time.Time
+	if window > 0 {
+		since = r.timegen.Now().Add(-window)
+	}
+
+	return buf.sensor, buf.since(since), nil
+}
+
+// ring keeps the latest values of a sensor, the oldest value is overwritten once it is full.
+type ring struct {
+	sensor core.Sensor
+	values []core.SensorValue
+	// next is the position of the next value, it is the oldest value once the ring is full
+	next int
+	// sampledAt is the time the sensor was read last, unlike the value timestamp it moves on the repeated reads too
+	sampledAt time.Time
+}
+
+func newRing(capacity int) *ring {
+	return &ring{values: make([]core.SensorValue, 0, capacity)}
+}
+
+// push records the value of the sensor read at the time, the value already recorded is skipped,
+// e.g. the one returned again by the cache.
+func (r *ring) push(sensor core.Sensor, sampledAt time.Time) {
+	r.sampledAt = sampledAt
+	if last, ok := r.last(); ok && !sensor.Value.Timestamp.After(last.Timestamp) {
+		r.sensor = sensor
+		return
+	}
+	r.sensor = sensor
+
+	if len(r.values) < cap(r.values) {
+		r.values = append(r.values, sensor.Value)
+	} else {
+		r.values[r.next] = sensor.Value
+	}
+	r.next = (r.next + 1) % cap(r.values)
+}
+
+func (r *ring) last() (core.SensorValue, bool) {
+	if len(r.values) == 0 {
+		return core.SensorValue{}, false
+	}
+
+	return r.values[(r.next-1+len(r.values))%len(r.values)], true
+}
+
+// since returns a copy of the values recorded at or after the time, oldest first.
+func (r *ring) since(t time.Time) []core.SensorValue {
+	ordered := r.values
+	if len(r.values) == cap(r.values) {
+		ordered = append(r.values[r.next:len(r.values):len(r.values)], r.values[:r.next]...)
+	}
+
+	out := make([]core.SensorValue, 0, len(ordered))
+	for _, value := range ordered {
+		if !value.Timestamp.Before(t) {
+			out = append(out, value)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,251 @@
+package history_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/history"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	cpu   = core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+	start = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+)
+
+func TestRepoGetSensorHistory(t *testing.T) {
+	t.Parallel()
+
+	// the values stop changing after the fifth read, the repeated ones are not recorded
+	statsRepo := &fakeStatsRepo{reads: 5, sensors: 3}
+	repo := newRepo(t, statsRepo, history.Config{Capacity: 3, MaxSensors: 2})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		_ = repo.Run(ctx)
+	}()
+	require.Eventually(t, func() bool {
+		return statsRepo.calls() > 6
+	}, time.Second, time.Millisecond)
+
+	tests := map[string]struct {
+		id         core.SensorID
+		window     time.Duration
+		wantValues []float64
+		wantErr    error
+	}{
+		"oldest values are overwritten": {
+			id:         sensorID(0),
+			wantValues: []float64{2, 3, 4},
+		},
+		"window": {
+			id:         sensorID(1),
+			window:     90 * time.Second,
+			wantValues: []float64{13, 14},
+		},
+		"sensor over the limit": {
+			id:      sensorID(2),
+			wantErr: core.ErrNotFound,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sensor, values, err := repo.GetSensorHistory(context.Background(), test.id, test.window)
+			if test.wantErr != nil {
+				require.ErrorIs(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.id, sensor.ID)
+			require.Equal(t, values[len(values)-1], sensor.Value)
+			require.Equal(t, test.wantValues, valuesOf(values))
+		})
+	}
+}
+
+func TestRepoEvictsGoneSensors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		clock     = &fakeClock{now: start}
+		statsRepo = &fakeSensorsRepo{ids: []core.SensorID{sensorID(0)}}
+	)
+	repo, err := history.NewRepo(statsRepo, clock, history.Config{
+		Enabled:        true,
+		SampleInterval: time.Millisecond,
+		Capacity:       10,
+		MaxSensors:     1,
+	}, logrus.New())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		_ = repo.Run(ctx)
+	}()
+	sampled := func(id core.SensorID) func() bool {
+		return func() bool {
+			_, _, err := repo.GetSensorHistory(context.Background(), id, 0)
+			return err == nil
+		}
+	}
+	require.Eventually(t, sampled(sensorID(0)), time.Second, time.Millisecond)
+
+	// the sensor is gone, but it holds the place until its values are older than the history keeps
+	statsRepo.set(sensorID(1))
+	reads := statsRepo.calls()
+	require.Eventually(t, func() bool {
+		return statsRepo.calls() > reads+2
+	}, time.Second, time.Millisecond)
+	_, _, err = repo.GetSensorHistory(context.Background(), sensorID(1), 0)
+	require.ErrorIs(t, err, core.ErrNotFound)
+
+	clock.advance(11 * time.Millisecond)
+	require.Eventually(t, sampled(sensorID(1)), time.Second, time.Millisecond)
+	_, _, err = repo.GetSensorHistory(context.Background(), sensorID(0), 0)
+	require.ErrorIs(t, err, core.ErrNotFound)
+}
+
+func TestRepoDisabled(t *testing.T) {
+	t.Parallel()
+
+	repo, err := history.NewRepo(&fakeStatsRepo{}, fakeTimeGenerator{}, history.Config{}, logrus.New())
+	require.NoError(t, err)
+
+	// Run returns at once, so the errgroup doesn't wait for it
+	require.NoError(t, repo.Run(context.Background()))
+
+	_, _, err = repo.GetSensorHistory(context.Background(), sensorID(0), 0)
+	require.ErrorIs(t, err, core.ErrHistoryDisabled)
+}
+
+func newRepo(t *testing.T, statsRepo core.StatsRepo, cfg history.Config) *history.Repo {
+	t.Helper()
+
+	cfg.Enabled = true
+	cfg.SampleInterval = time.Millisecond
+
+	repo, err := history.NewRepo(statsRepo, fakeTimeGenerator{}, cfg, logrus.New())
+	require.NoError(t, err)
+
+	return repo
+}
+
+func sensorID(idx int) core.SensorID {
+	return core.SensorID("/intelcpu/0/load/" + string(rune('0'+idx)))
+}
+
+func valuesOf(values []core.SensorValue) []float64 {
+	out := make([]float64, len(values))
+	for idx, value := range values {
+		out[idx] = value.Value
+	}
+	return out
+}
+
+// fakeTimeGenerator returns the time of the last changing read.
+type fakeTimeGenerator struct{}
+
+func (fakeTimeGenerator) Now() time.Time {
+	return start.Add(4 * time.Minute)
+}
+
+// fakeStatsRepo returns the sensors whose values change once a minute for the first reads,
+// the value of the sensor N at the read R is N*10+R.
+type fakeStatsRepo struct {
+	reads   int
+	sensors int
+
+	mux sync.Mutex
+	n   int
+}
+
+func (r *fakeStatsRepo) GetSensorsByHardware(_ context.Context, _ core.StatsFilter) (map[core.Hardware][]core.Sensor, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	read := min(r.n, r.reads-1)
+	r.n++
+
+	sensors := make([]core.Sensor, r.sensors)
+	for idx := range sensors {
+		sensors[idx] = core.Sensor{
+			ID:         sensorID(idx),
+			HardwareID: cpu.ID,
+			Type:       core.Load,
+			Value: core.SensorValue{
+				Value:     float64(idx*10 + read),
+				Timestamp: start.Add(time.Duration(read) * time.Minute),
+			},
+		}
+	}
+
+	return map[core.Hardware][]core.Sensor{cpu: sensors}, nil
+}
+
+func (r *fakeStatsRepo) calls() int {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.n
+}
+
+type fakeClock struct {
+	mux sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// fakeSensorsRepo returns the given sensors with the constant values.
+type fakeSensorsRepo struct {
+	mux sync.Mutex
+	ids []core.SensorID
+	n   int
+}
+
+func (r *fakeSensorsRepo) GetSensorsByHardware(_ context.Context, _ core.StatsFilter) (map[core.Hardware][]core.Sensor, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.n++
+	sensors := make([]core.Sensor, len(r.ids))
+	for idx, id := range r.ids {
+		sensors[idx] = core.Sensor{ID: id, HardwareID: cpu.ID, Type: core.Load, Value: core.SensorValue{Value: 1, Timestamp: start}}
+	}
+
+	return map[core.Hardware][]core.Sensor{cpu: sensors}, nil
+}
+
+func (r *fakeSensorsRepo) set(ids ...core.SensorID) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.ids = ids
+}
+
+func (r *fakeSensorsRepo) calls() int {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.n
+}
@@ -0,0 +1,269 @@
+// Package hwmon reads the hardware sensors exposed by the Linux kernel through the hwmon sysfs interface.
+package hwmon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/samber/lo"
+)
+
+type (
+	Config struct {
+		Root string `envconfig:"APP_HWMON_ROOT" default:"/sys/class/hwmon"`
+	}
+
+	TimeGenerator interface {
+		Now() time.Time
+	}
+
+	Repo struct {
+		root    string
+		timegen TimeGenerator
+	}
+)
+
+func NewRepo(cfg Config, timegen TimeGenerator) (*Repo, error) {
+	if cfg.Root == "" {
+		return nil, errors.New("hwmon root is empty")
+	}
+	if lo.IsNil(timegen) {
+		return nil, errors.New("time generator is nil")
+	}
+	return &Repo{
+		root:    cfg.Root,
+		timegen: timegen,
+	}, nil
+}
+
+func (r *Repo) GetHardware(ctx context.Context, _ core.StatsFilter) ([]core.Hardware, error) {
+	chips, err := r.readChips(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read chips: %w", err)
+	}
+
+	return lo.Map(chips, func(c chip, _ int) core.Hardware {
+		return c.toCoreHardware()
+	}), nil
+}
+
+func (r *Repo) GetSensors(ctx context.Context, _ core.StatsFilter) ([]core.Sensor, error) {
+	chips, err := r.readChips(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read chips: %w", err)
+	}
+
+	now := r.timegen.Now()
+
+	var sensors []core.Sensor
+	for _, c := range chips {
+		sensors = append(sensors, c.toCoreSensors(now)...)
+	}
+
+	return sensors, nil
+}
+
+type (
+	// chip is a single hwmon device, e.g. /sys/class/hwmon/hwmon3.
+	chip struct {
+		id       core.HardwareID
+		name     string
+		driver   string
+		channels []channel
+	}
+
+	// channel is a single reading of a chip, e.g. temp1_input together with temp1_label.
+	channel struct {
+		kind  channelKind
+		index int
+		label string
+		value float64
+		// min and max are the lowest and highest values since the driver was loaded,
+		// they fall back to the current value if the driver does not track them.
+		min float64
+		max float64
+	}
+)
+
+// inputPattern matches the attributes holding the current reading of a channel.
+// Some drivers (e.g. amdgpu) expose only the averaged power instead of the instant one.
+var inputPattern = regexp.MustCompile(`^(temp|fan|in|power|curr)(\d+)_(input|average)$`)
+
+func (r *Repo) readChips(ctx context.Context) ([]chip, error) {
+	entries, err := os.ReadDir(r.root)
+	if err != nil {
+		return nil, fmt.Errorf("read hwmon root %s: %w", r.root, err)
+	}
+
+	dirs := lo.FilterMap(entries, func(e os.DirEntry, _ int) (string, bool) {
+		return e.Name(), strings.HasPrefix(e.Name(), "hwmon")
+	})
+	// hwmon10 must go after hwmon9, so the identifiers do not depend on the lexical order
+	slices.SortFunc(dirs, func(a, b string) int {
+		return hwmonNumber(a) - hwmonNumber(b)
+	})
+
+	chips := make([]chip, 0, len(dirs))
+	seen := make(map[string]int, len(dirs))
+	for _, dir := range dirs {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		c, ok, err := readChip(filepath.Join(r.root, dir))
+		if err != nil {
+			return nil, fmt.Errorf("read chip %s: %w", dir, err)
+		}
+		if !ok {
+			continue
+		}
+
+		c.id = core.HardwareID(fmt.Sprintf("/%s/%d", c.driver, seen[c.driver]))
+		seen[c.driver]++
+
+		chips = append(chips, c)
+	}
+
+	return chips, nil
+}
+
+// readChip reads the attributes of a single hwmon device.
+// Older kernels keep the attributes in the device subdirectory instead of the hwmon one,
+// both layouts are supported. It returns false if the directory is not a hwmon device.
+func readChip(dir string) (chip, bool, error) {
+	driver, ok := readAttribute(dir, "name")
+	if !ok {
+		dir = filepath.Join(dir, "device")
+		if driver, ok = readAttribute(dir, "name"); !ok {
+			return chip{}, false, nil
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return chip{}, false, fmt.Errorf("read dir: %w", err)
+	}
+
+	c := chip{
+		name:   driver,
+		driver: driver,
+	}
+	// storage devices expose their model, it is much more descriptive than the driver name
+	if model, found := readAttribute(filepath.Join(dir, "device"), "model"); found {
+		c.name = model
+	}
+
+	seen := make(map[string]struct{}, len(entries))
+	for _, e := range entries {
+		match := inputPattern.FindStringSubmatch(e.Name())
+		if match == nil {
+			continue
+		}
+		prefix := match[1] + match[2]
+		if _, ok = seen[prefix]; ok {
+			continue
+		}
+
+		ch, found := readChannel(dir, channelKind(match[1]), match[2])
+		if !found {
+			continue
+		}
+		seen[prefix] = struct{}{}
+		c.channels = append(c.channels, ch)
+	}
+
+	slices.SortFunc(c.channels, func(a, b channel) int {
+		if a.kind != b.kind {
+			return strings.Compare(string(a.kind), string(b.kind))
+		}
+		return a.index - b.index
+	})
+
+	return c, true, nil
+}
+
+// readChannel reads the channel with the given kind and number, e.g. temp and 1 for temp1_*.
+// It returns false if the channel has no readable value: the kernel reports errors like ENODATA
+// for the sensors that are present but not connected, such channels are skipped.
+func readChannel(dir string, kind channelKind, number string) (channel, bool) {
+	index, err := strconv.Atoi(number)
+	if err != nil {
+		return channel{}, false
+	}
+
+	prefix := string(kind) + number
+
+	raw, ok := readAttribute(dir, prefix+"_input")
+	if !ok {
+		if raw, ok = readAttribute(dir, prefix+"_average"); !ok {
+			return channel{}, false
+		}
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return channel{}, false
+	}
+
+	label, ok := readAttribute(dir, prefix+"_label")
+	if !ok {
+		label = fmt.Sprintf("%s %d", kind.sensorType(), index)
+	}
+
+	value /= kind.scale()
+
+	return channel{
+		kind:  kind,
+		index: index,
+		label: label,
+		value: value,
+		min:   readHistoryValue(dir, kind, prefix, "lowest", value),
+		max:   readHistoryValue(dir, kind, prefix, "highest", value),
+	}, true
+}
+
+// readHistoryValue reads the lowest or highest value of the channel, e.g. temp1_highest or power1_input_highest.
+func readHistoryValue(dir string, kind channelKind, prefix, suffix string, fallback float64) float64 {
+	name := prefix + "_" + suffix
+	if kind == powerChannel {
+		name = prefix + "_input_" + suffix
+	}
+
+	raw, ok := readAttribute(dir, name)
+	if !ok {
+		return fallback
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return fallback
+	}
+
+	return value / kind.scale()
+}
+
+func readAttribute(dir, name string) (string, bool) {
+	content, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return "", false
+	}
+	value := strings.TrimSpace(string(content))
+	return value, value != ""
+}
+
+func hwmonNumber(dir string) int {
+	n, err := strconv.Atoi(strings.TrimPrefix(dir, "hwmon"))
+	if err != nil {
+		return -1
+	}
+	return n
+}
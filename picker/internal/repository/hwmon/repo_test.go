@@ -0,0 +1,145 @@
+package hwmon_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/hwmon"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/prometheus"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/stats"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoGetSensorsByHardware(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"hwmon0/name":                 "coretemp",
+		"hwmon0/temp1_input":          "45000",
+		"hwmon0/temp1_label":          "Package id 0",
+		"hwmon0/temp1_lowest":         "31000",
+		"hwmon0/temp1_highest":        "87500",
+		"hwmon0/temp2_input":          "43500",
+		"hwmon1/name":                 "nct6775",
+		"hwmon1/fan2_input":           "1200",
+		"hwmon1/fan2_label":           "CPU Fan",
+		"hwmon1/in0_input":            "2250",
+		"hwmon1/in0_label":            "Vcore",
+		"hwmon1/in1_input":            "",
+		"hwmon2/name":                 "amdgpu",
+		"hwmon2/power1_average":       "35000000",
+		"hwmon2/power1_input_highest": "120000000",
+		"hwmon2/curr1_input":          "3000",
+		"hwmon10/name":                "nvme",
+		"hwmon10/temp1_input":         "38850",
+		"hwmon3/name":                 "nvme",
+		"hwmon3/device/model":         "Samsung SSD 980 PRO 1TB",
+		"hwmon3/temp1_input":          "41000",
+		"hwmon3/temp1_label":          "Composite",
+		"hwmon4/device/name":          "acpitz",
+		"hwmon4/device/temp1_input":   "27800",
+		"hwmon5/uevent":               "",
+		"hwmon6/name":                 "mystery",
+		"hwmon6/temp1_input":          "33000",
+		"power_supply":                "",
+	})
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	source, err := hwmon.NewRepo(hwmon.Config{Root: root}, fixedTime(now))
+	require.NoError(t, err)
+	repo, err := stats.NewRepo(source, prometheus.NewUnknownItemsReporter(), prometheus.NewSelfReporter("hwmon"))
+	require.NoError(t, err)
+
+	var (
+		cpu     = core.Hardware{ID: "/coretemp/0", Name: "coretemp", Type: core.CPU, Subtype: "coretemp"}
+		superIO = core.Hardware{ID: "/nct6775/0", Name: "nct6775", Type: core.SuperIO, Subtype: "nct6775"}
+		gpu     = core.Hardware{ID: "/amdgpu/0", Name: "amdgpu", Type: core.GPU, Subtype: "amdgpu"}
+		nvme0   = core.Hardware{ID: "/nvme/0", Name: "Samsung SSD 980 PRO 1TB", Type: core.Storage, Subtype: "nvme"}
+		nvme1   = core.Hardware{ID: "/nvme/1", Name: "nvme", Type: core.Storage, Subtype: "nvme"}
+		board   = core.Hardware{ID: "/acpitz/0", Name: "acpitz", Type: core.Motherboard, Subtype: "acpitz"}
+		unknown = core.Hardware{
+			ID: "/mystery/0", Name: "mystery", Type: core.UnknownHardwareType, RawType: "mystery", Subtype: "mystery",
+		}
+	)
+
+	sensor := func(hw core.Hardware, id, name string, sensorType core.SensorType, index int, value float64) core.Sensor {
+		return core.Sensor{
+			ID:         core.SensorID(id),
+			HardwareID: hw.ID,
+			Name:       name,
+			Type:       sensorType,
+			Index:      index,
+			Value:      core.SensorValue{Value: value, Min: value, Max: value, Timestamp: now},
+		}
+	}
+	withRange := func(s core.Sensor, minValue, maxValue float64) core.Sensor {
+		s.Value.Min, s.Value.Max = minValue, maxValue
+		return s
+	}
+
+	want := map[core.Hardware][]core.Sensor{
+		cpu: {
+			withRange(sensor(cpu, "/coretemp/0/temperature/1", "Package id 0", core.Temperature, 1, 45), 31, 87.5),
+			sensor(cpu, "/coretemp/0/temperature/2", "Temperature 2", core.Temperature, 2, 43.5),
+		},
+		superIO: {
+			sensor(superIO, "/nct6775/0/voltage/0", "Vcore", core.Voltage, 0, 2.25),
+			sensor(superIO, "/nct6775/0/fan/2", "CPU Fan", core.Fan, 2, 1200),
+		},
+		gpu: {
+			withRange(sensor(gpu, "/amdgpu/0/power/1", "Power 1", core.Power, 1, 35), 35, 120),
+			sensor(gpu, "/amdgpu/0/current/1", "Current 1", core.Current, 1, 3),
+		},
+		nvme0: {
+			sensor(nvme0, "/nvme/0/temperature/1", "Composite", core.Temperature, 1, 41),
+		},
+		nvme1: {
+			sensor(nvme1, "/nvme/1/temperature/1", "Temperature 1", core.Temperature, 1, 38.85),
+		},
+		board: {
+			sensor(board, "/acpitz/0/temperature/1", "Temperature 1", core.Temperature, 1, 27.8),
+		},
+		unknown: {
+			sensor(unknown, "/mystery/0/temperature/1", "Temperature 1", core.Temperature, 1, 33),
+		},
+	}
+
+	got, err := repo.GetSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestRepoGetHardwareMissingRoot(t *testing.T) {
+	t.Parallel()
+
+	source, err := hwmon.NewRepo(
+		hwmon.Config{Root: filepath.Join(t.TempDir(), "missing")},
+		fixedTime(time.Now()),
+	)
+	require.NoError(t, err)
+
+	_, err = source.GetHardware(context.Background(), core.StatsFilter{})
+	require.Error(t, err)
+}
+
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content+"\n"), 0o600))
+	}
+}
+
+type fixedTime time.Time
+
+func (f fixedTime) Now() time.Time {
+	return time.Time(f)
+}
@@ -0,0 +1,114 @@
+package hwmon
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+)
+
+// channelKind is the prefix of the sysfs attributes of a channel.
+// See https://www.kernel.org/doc/html/latest/hwmon/sysfs-interface.html
+type channelKind string
+
+const (
+	temperatureChannel channelKind = "temp"  // millidegree Celsius
+	fanChannel         channelKind = "fan"   // revolutions per minute
+	voltageChannel     channelKind = "in"    // millivolt
+	powerChannel       channelKind = "power" // microwatt
+	currentChannel     channelKind = "curr"  // milliampere
+)
+
+func (k channelKind) sensorType() core.SensorType {
+	switch k {
+	case temperatureChannel:
+		return core.Temperature
+	case fanChannel:
+		return core.Fan
+	case voltageChannel:
+		return core.Voltage
+	case powerChannel:
+		return core.Power
+	case currentChannel:
+		return core.Current
+	default:
+		return core.UnknownSensorType
+	}
+}
+
+// scale returns the divisor converting the raw sysfs value into the unit used by LibreHardwareMonitor.
+func (k channelKind) scale() float64 {
+	switch k {
+	case temperatureChannel, voltageChannel, currentChannel:
+		return 1e3
+	case powerChannel:
+		return 1e6
+	default:
+		return 1
+	}
+}
+
+func (c chip) toCoreHardware() core.Hardware {
+	hw := core.Hardware{
+		ID:      c.id,
+		Name:    c.name,
+		Type:    toCoreHardwareType(c.driver),
+		Subtype: c.driver,
+	}
+	if hw.Type == core.UnknownHardwareType {
+		hw.RawType = c.driver
+	}
+	return hw
+}
+
+func (c chip) toCoreSensors(now time.Time) []core.Sensor {
+	out := make([]core.Sensor, len(c.channels))
+	for idx, ch := range c.channels {
+		sensorType := ch.kind.sensorType()
+		out[idx] = core.Sensor{
+			ID:         core.SensorID(fmt.Sprintf("%s/%s/%d", c.id, strings.ToLower(sensorType.String()), ch.index)),
+			HardwareID: c.id,
+			Name:       ch.label,
+			Type:       sensorType,
+			Index:      ch.index,
+			Value: core.SensorValue{
+				Value:     ch.value,
+				Min:       ch.min,
+				Max:       ch.max,
+				Timestamp: now,
+			},
+		}
+	}
+	return out
+}
+
+// toCoreHardwareType guesses the hardware type by the name of the driver that registered the hwmon device.
+func toCoreHardwareType(driver string) core.HardwareType {
+	switch driver {
+	case "coretemp", "k8temp", "k10temp", "zenpower", "fam15h_power", "cpu_thermal":
+		return core.CPU
+	case "amdgpu", "radeon", "nouveau", "i915", "xe":
+		return core.GPU
+	case "nvme", "drivetemp":
+		return core.Storage
+	case "jc42", "spd5118", "ee1004":
+		return core.RAM
+	case "acpitz", "thinkpad", "dell_smm":
+		return core.Motherboard
+	}
+
+	switch {
+	case strings.HasPrefix(driver, "nct"), strings.HasPrefix(driver, "it87"),
+		strings.HasPrefix(driver, "w83"), strings.HasPrefix(driver, "f71"):
+		return core.SuperIO
+	case strings.HasPrefix(driver, "pch_"), strings.HasPrefix(driver, "asus"):
+		return core.Motherboard
+	case strings.HasPrefix(driver, "BAT"), strings.HasPrefix(driver, "battery"):
+		return core.Battery
+	case strings.HasPrefix(driver, "iwlwifi"), strings.HasPrefix(driver, "r8169"), strings.HasPrefix(driver, "mt79"):
+		return core.Network
+	default:
+		return core.UnknownHardwareType
+	}
+}
@@ -0,0 +1,118 @@
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
+)
+
+// the labels are the same as the ones of the prometheus-collector StatsReporter,
+// so the dashboards built for it work with the stats scraped from picker directly
+const (
+	hostLabel            = "host"
+	hardwareIDLabel      = "hardwareID"
+	hardwareNameLabel    = "hardwareName"
+	hardwareTypeLabel    = "hardwareType"
+	hardwareSubtypeLabel = "hardwareSubtype"
+	sensorIDLabel        = "sensorID"
+	sensorNameLabel      = "sensorName"
+	sensorTypeLabel      = "sensorType"
+)
+
+type CollectorConfig struct {
+	// Host is the value of the host label, the host name is used if it is empty.
+	// Set it to the picker address configured in prometheus-collector to keep the existing series.
+	Host          string        `envconfig:"APP_METRICS_HOST"`
+	ScrapeTimeout time.Duration `envconfig:"APP_METRICS_SCRAPE_TIMEOUT" default:"5s"`
+}
+
+// StatsCollector exposes the sensor values read from the repo on every scrape.
+type StatsCollector struct {
+	statsRepo     core.StatsRepo
+	host          string
+	scrapeTimeout time.Duration
+
+	sensorValueDesc *prometheus.Desc
+}
+
+func NewStatsCollector(statsRepo core.StatsRepo, cfg CollectorConfig) (*StatsCollector, error) {
+	if lo.IsNil(statsRepo) {
+		return nil, errors.New("stats repo is nil")
+	}
+	if cfg.ScrapeTimeout <= 0 {
+		return nil, errors.New("scrape timeout must be positive")
+	}
+
+	host := cfg.Host
+	if host == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("get hostname: %w", err)
+		}
+		host = hostname
+	}
+
+	return &StatsCollector{
+		statsRepo:     statsRepo,
+		host:          host,
+		scrapeTimeout: cfg.ScrapeTimeout,
+		sensorValueDesc: prometheus.NewDesc(
+			"sensor_value",
+			"Sensor value",
+			[]string{
+				hostLabel, hardwareIDLabel, hardwareNameLabel, hardwareTypeLabel, hardwareSubtypeLabel,
+				sensorIDLabel, sensorNameLabel, sensorTypeLabel,
+			},
+			nil,
+		),
+	}, nil
+}
+
+// Register registers the collector with the registerer, it is a dedicated one served by the API server,
+// so the sensor metrics are not mixed with the ones of picker itself.
+func (c *StatsCollector) Register(registerer prometheus.Registerer) error {
+	if err := registerer.Register(c); err != nil {
+		return fmt.Errorf("register stats collector: %w", err)
+	}
+
+	return nil
+}
+
+func (c *StatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.sensorValueDesc
+}
+
+func (c *StatsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.scrapeTimeout)
+	defer cancel()
+
+	sensorsByHardware, err := c.statsRepo.GetSensorsByHardware(ctx, core.StatsFilter{})
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.sensorValueDesc, fmt.Errorf("get sensors: %w", err))
+		return
+	}
+
+	for hw, sensors := range sensorsByHardware {
+		for _, sensor := range sensors {
+			ch <- prometheus.MustNewConstMetric(
+				c.sensorValueDesc,
+				prometheus.GaugeValue,
+				sensor.Value.Value,
+				c.host,
+				string(hw.ID),
+				hw.Name,
+				hw.Type.String(),
+				hw.Subtype,
+				string(sensor.ID),
+				sensor.Name,
+				sensor.Type.String(),
+			)
+		}
+	}
+}
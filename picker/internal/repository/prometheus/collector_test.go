@@ -0,0 +1,66 @@
+package prometheus_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsCollector(t *testing.T) {
+	t.Parallel()
+
+	var (
+		cpu   = core.Hardware{ID: "/intelcpu/0", Name: "Intel Core i7", Type: core.CPU, Subtype: "Cpu"}
+		board = core.Hardware{ID: "/motherboard", Name: "Board", Type: core.Motherboard}
+		repo  = &fakeStatsRepo{
+			stats: map[core.Hardware][]core.Sensor{
+				cpu: {
+					{ID: "/intelcpu/0/temperature/0", HardwareID: cpu.ID, Name: "CPU Package", Type: core.Temperature, Value: core.SensorValue{Value: 42.5}},
+					{ID: "/intelcpu/0/load/0", HardwareID: cpu.ID, Name: "CPU Total", Type: core.Load, Value: core.SensorValue{Value: 7.25}},
+				},
+				board: nil,
+			},
+		}
+	)
+
+	collector, err := prometheus.NewStatsCollector(repo, prometheus.CollectorConfig{Host: "http://192.168.0.2:8080", ScrapeTimeout: time.Second})
+	require.NoError(t, err)
+
+	expected := `
+# HELP sensor_value Sensor value
+# TYPE sensor_value gauge
+sensor_value{hardwareID="/intelcpu/0",hardwareName="Intel Core i7",hardwareSubtype="Cpu",hardwareType="CPU",host="http://192.168.0.2:8080",sensorID="/intelcpu/0/load/0",sensorName="CPU Total",sensorType="Load"} 7.25
+sensor_value{hardwareID="/intelcpu/0",hardwareName="Intel Core i7",hardwareSubtype="Cpu",hardwareType="CPU",host="http://192.168.0.2:8080",sensorID="/intelcpu/0/temperature/0",sensorName="CPU Package",sensorType="Temperature"} 42.5
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+
+	// the stats are read on every scrape
+	repo.stats[cpu][1].Value.Value = 99
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(strings.Replace(expected, "} 7.25", "} 99", 1))))
+}
+
+func TestStatsCollectorRepoError(t *testing.T) {
+	t.Parallel()
+
+	collector, err := prometheus.NewStatsCollector(&fakeStatsRepo{err: errors.New("wmi is down")}, prometheus.CollectorConfig{ScrapeTimeout: time.Second})
+	require.NoError(t, err)
+
+	_, err = testutil.CollectAndLint(collector)
+	require.ErrorContains(t, err, "wmi is down")
+}
+
+type fakeStatsRepo struct {
+	stats map[core.Hardware][]core.Sensor
+	err   error
+}
+
+func (r *fakeStatsRepo) GetSensorsByHardware(_ context.Context, _ core.StatsFilter) (map[core.Hardware][]core.Sensor, error) {
+	return r.stats, r.err
+}
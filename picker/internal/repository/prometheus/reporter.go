@@ -0,0 +1,51 @@
+// Package prometheus provides reporting to Prometheus metrics.
+package prometheus
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "picker"
+
+	kindLabel    = "kind"
+	rawTypeLabel = "rawType"
+
+	hardwareKind = "hardware"
+	sensorKind   = "sensor"
+)
+
+type UnknownItemsReporter struct {
+	unknownItemsCounterVec *prometheus.CounterVec
+}
+
+func NewUnknownItemsReporter() *UnknownItemsReporter {
+	return &UnknownItemsReporter{
+		unknownItemsCounterVec: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "unknown_items_total",
+				Help:      "Number of the hardware and sensors of the types unknown to picker read from the backend",
+			},
+			[]string{kindLabel, rawTypeLabel},
+		),
+	}
+}
+
+func (r *UnknownItemsReporter) Register() error {
+	if err := prometheus.Register(r.unknownItemsCounterVec); err != nil {
+		return fmt.Errorf("register unknown items counter vec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *UnknownItemsReporter) ReportUnknownHardware(rawType string) {
+	r.unknownItemsCounterVec.WithLabelValues(hardwareKind, rawType).Inc()
+}
+
+func (r *UnknownItemsReporter) ReportUnknownSensor(rawType string) {
+	r.unknownItemsCounterVec.WithLabelValues(sensorKind, rawType).Inc()
+}
@@ -0,0 +1,157 @@
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	backendLabel = "backend"
+	queryLabel   = "query"
+	causeLabel   = "cause"
+	resultLabel  = "result"
+	sharedLabel  = "shared"
+
+	timeoutCause  = "timeout"
+	canceledCause = "canceled"
+	errorCause    = "error"
+
+	successResult = "success"
+	failureResult = "failure"
+)
+
+// SelfReporter reports how picker itself performs: the backend query latency and errors, the cache efficiency,
+// the number of the reads shared by the concurrent requests and the size of the snapshots.
+type SelfReporter struct {
+	backend string
+
+	queryDuration  *prometheus.HistogramVec
+	queryErrors    *prometheus.CounterVec
+	snapshotSize   *prometheus.HistogramVec
+	cacheLookups   *prometheus.CounterVec
+	cacheRefreshes *prometheus.CounterVec
+	sharedCalls    *prometheus.CounterVec
+}
+
+// NewSelfReporter creates the reporter of the backend the stats are read from, e.g. wmi.
+func NewSelfReporter(backend string) *SelfReporter {
+	return &SelfReporter{
+		backend: backend,
+		queryDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "source_query_duration_seconds",
+				Help:      "Duration of the backend queries, e.g. the WMI queries to LibreHardwareMonitor, by the queried items",
+				// LibreHardwareMonitor may take seconds to respond when it's busy
+				Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+			},
+			[]string{backendLabel, queryLabel},
+		),
+		queryErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "source_query_errors_total",
+				Help:      "Number of the failed backend queries by the cause: timeout, canceled or error",
+			},
+			[]string{backendLabel, queryLabel, causeLabel},
+		),
+		snapshotSize: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "snapshot_items",
+				Help:      "Number of the hardware and sensors read from the backend at once",
+				Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+			},
+			[]string{kindLabel},
+		),
+		cacheLookups: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "cache_lookups_total",
+				Help:      "Number of the stats cache lookups by the result: hit, stale, miss or fallback",
+			},
+			[]string{resultLabel},
+		),
+		cacheRefreshes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "cache_refreshes_total",
+				Help:      "Number of the stats cache refreshes by the result: success or failure",
+			},
+			[]string{resultLabel},
+		),
+		sharedCalls: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "singleflight_calls_total",
+				Help:      "Number of the stats reads, shared is true for the concurrent reads that got the result of a single one",
+			},
+			[]string{sharedLabel},
+		),
+	}
+}
+
+func (r *SelfReporter) Register(registerer prometheus.Registerer) error {
+	collectors := []struct {
+		name      string
+		collector prometheus.Collector
+	}{
+		{name: "query duration", collector: r.queryDuration},
+		{name: "query errors", collector: r.queryErrors},
+		{name: "snapshot size", collector: r.snapshotSize},
+		{name: "cache lookups", collector: r.cacheLookups},
+		{name: "cache refreshes", collector: r.cacheRefreshes},
+		{name: "shared calls", collector: r.sharedCalls},
+	}
+	for _, c := range collectors {
+		if err := registerer.Register(c.collector); err != nil {
+			return fmt.Errorf("register %s: %w", c.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *SelfReporter) ObserveQuery(query string, duration time.Duration, err error) {
+	r.queryDuration.WithLabelValues(r.backend, query).Observe(duration.Seconds())
+	if err != nil {
+		r.queryErrors.WithLabelValues(r.backend, query, errorCauseOf(err)).Inc()
+	}
+}
+
+func (r *SelfReporter) ReportSnapshotSize(hardware, sensors int) {
+	r.snapshotSize.WithLabelValues(hardwareKind).Observe(float64(hardware))
+	r.snapshotSize.WithLabelValues(sensorKind).Observe(float64(sensors))
+}
+
+func (r *SelfReporter) ReportCacheLookup(result string) {
+	r.cacheLookups.WithLabelValues(result).Inc()
+}
+
+func (r *SelfReporter) ReportCacheRefresh(err error) {
+	result := successResult
+	if err != nil {
+		result = failureResult
+	}
+	r.cacheRefreshes.WithLabelValues(result).Inc()
+}
+
+func (r *SelfReporter) ReportSingleflightCall(shared bool) {
+	r.sharedCalls.WithLabelValues(strconv.FormatBool(shared)).Inc()
+}
+
+func errorCauseOf(err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return timeoutCause
+	case errors.Is(err, context.Canceled):
+		return canceledCause
+	default:
+		return errorCause
+	}
+}
@@ -0,0 +1,70 @@
+package prometheus_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/repository/prometheus"
+	prometheusclient "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfReporter(t *testing.T) {
+	t.Parallel()
+
+	registry := prometheusclient.NewRegistry()
+	reporter := prometheus.NewSelfReporter("wmi")
+	require.NoError(t, reporter.Register(registry))
+
+	reporter.ObserveQuery("hardware", 20*time.Millisecond, nil)
+	reporter.ObserveQuery("sensors", time.Second, fmt.Errorf("query sensors: %w", context.DeadlineExceeded))
+	reporter.ObserveQuery("sensors", time.Millisecond, context.Canceled)
+	reporter.ObserveQuery("sensors", time.Millisecond, errors.New("wmi is down"))
+	reporter.ReportCacheLookup("hit")
+	reporter.ReportCacheLookup("hit")
+	reporter.ReportCacheLookup("miss")
+	reporter.ReportCacheRefresh(nil)
+	reporter.ReportCacheRefresh(errors.New("wmi is down"))
+	reporter.ReportSingleflightCall(true)
+	reporter.ReportSingleflightCall(false)
+
+	expected := `
+# HELP picker_cache_lookups_total Number of the stats cache lookups by the result: hit, stale, miss or fallback
+# TYPE picker_cache_lookups_total counter
+picker_cache_lookups_total{result="hit"} 2
+picker_cache_lookups_total{result="miss"} 1
+# HELP picker_cache_refreshes_total Number of the stats cache refreshes by the result: success or failure
+# TYPE picker_cache_refreshes_total counter
+picker_cache_refreshes_total{result="failure"} 1
+picker_cache_refreshes_total{result="success"} 1
+# HELP picker_singleflight_calls_total Number of the stats reads, shared is true for the concurrent reads that got the result of a single one
+# TYPE picker_singleflight_calls_total counter
+picker_singleflight_calls_total{shared="false"} 1
+picker_singleflight_calls_total{shared="true"} 1
+# HELP picker_source_query_errors_total Number of the failed backend queries by the cause: timeout, canceled or error
+# TYPE picker_source_query_errors_total counter
+picker_source_query_errors_total{backend="wmi",cause="canceled",query="sensors"} 1
+picker_source_query_errors_total{backend="wmi",cause="error",query="sensors"} 1
+picker_source_query_errors_total{backend="wmi",cause="timeout",query="sensors"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(
+		registry,
+		strings.NewReader(expected),
+		"picker_cache_lookups_total",
+		"picker_cache_refreshes_total",
+		"picker_singleflight_calls_total",
+		"picker_source_query_errors_total",
+	))
+
+	count, err := testutil.GatherAndCount(registry, "picker_source_query_duration_seconds")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	// the metrics are registered once per registry
+	require.Error(t, prometheus.NewSelfReporter("wmi").Register(registry))
+}
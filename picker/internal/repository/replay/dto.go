@@ -0,0 +1,129 @@
+package replay
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+)
+
+// snapshot is a single line of a recording.
+// The types are stored by their names, so a recording survives the reordering of the enums.
+type (
+	snapshot struct {
+		Timestamp time.Time  `json:"timestamp"`
+		Hardware  []hardware `json:"hardware"`
+	}
+
+	hardware struct {
+		ID      string   `json:"id"`
+		Parent  string   `json:"parent,omitempty"`
+		Name    string   `json:"name"`
+		Type    string   `json:"type"`
+		RawType string   `json:"rawType,omitempty"`
+		Subtype string   `json:"subtype,omitempty"`
+		Sensors []sensor `json:"sensors"`
+	}
+
+	sensor struct {
+		ID      string  `json:"id"`
+		Name    string  `json:"name"`
+		Type    string  `json:"type"`
+		RawType string  `json:"rawType,omitempty"`
+		Index   int     `json:"index"`
+		Value   float64 `json:"value"`
+		Min     float64 `json:"min"`
+		Max     float64 `json:"max"`
+	}
+)
+
+func fromCore(timestamp time.Time, in map[core.Hardware][]core.Sensor) snapshot {
+	out := snapshot{
+		Timestamp: timestamp,
+		Hardware:  make([]hardware, 0, len(in)),
+	}
+
+	for hw, sensors := range in {
+		h := hardware{
+			ID:      string(hw.ID),
+			Parent:  string(hw.ParentID),
+			Name:    hw.Name,
+			Type:    hw.Type.String(),
+			RawType: hw.RawType,
+			Subtype: hw.Subtype,
+			Sensors: make([]sensor, len(sensors)),
+		}
+		for idx, s := range sensors {
+			h.Sensors[idx] = sensor{
+				ID:      string(s.ID),
+				Name:    s.Name,
+				Type:    s.Type.String(),
+				RawType: s.RawType,
+				Index:   s.Index,
+				Value:   s.Value.Value,
+				Min:     s.Value.Min,
+				Max:     s.Value.Max,
+			}
+		}
+		out.Hardware = append(out.Hardware, h)
+	}
+
+	// the map is unordered, sorting keeps the recordings diffable
+	slices.SortFunc(out.Hardware, func(a, b hardware) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return out
+}
+
+// toCoreHardware keeps the hardware of the types unknown to picker, e.g. recorded by a newer version,
+// such hardware has the unknown type and the recorded type name as the raw one.
+func (s snapshot) toCoreHardware() []core.Hardware {
+	out := make([]core.Hardware, len(s.Hardware))
+	for idx, hw := range s.Hardware {
+		out[idx] = core.Hardware{
+			ID:       core.HardwareID(hw.ID),
+			ParentID: core.HardwareID(hw.Parent),
+			Name:     hw.Name,
+			RawType:  hw.RawType,
+			Subtype:  hw.Subtype,
+		}
+		t, err := core.ParseHardwareType(hw.Type)
+		if err != nil {
+			out[idx].RawType = cmp.Or(hw.RawType, hw.Type)
+		}
+		out[idx].Type = t
+	}
+
+	return out
+}
+
+func (s snapshot) toCoreSensors(now time.Time) []core.Sensor {
+	var out []core.Sensor
+	for _, hw := range s.Hardware {
+		for _, sn := range hw.Sensors {
+			rawType := sn.RawType
+			t, err := core.ParseSensorType(sn.Type)
+			if err != nil {
+				rawType = cmp.Or(sn.RawType, sn.Type)
+			}
+			out = append(out, core.Sensor{
+				ID:         core.SensorID(sn.ID),
+				HardwareID: core.HardwareID(hw.ID),
+				Name:       sn.Name,
+				Type:       t,
+				RawType:    rawType,
+				Index:      sn.Index,
+				Value: core.SensorValue{
+					Value:     sn.Value,
+					Min:       sn.Min,
+					Max:       sn.Max,
+					Timestamp: now,
+				},
+			})
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,100 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+type (
+	RecorderConfig struct {
+		// Path is the NDJSON file the snapshots are appended to, the recording is disabled if it is empty.
+		Path string `envconfig:"APP_STATS_RECORD_PATH"`
+	}
+
+	TimeGenerator interface {
+		Now() time.Time
+	}
+
+	// Recorder appends every snapshot read from the base repo to a file, one JSON object per line.
+	Recorder struct {
+		baseRepo core.StatsRepo
+		timegen  TimeGenerator
+		file     *os.File
+		encoder  *json.Encoder
+		logger   logrus.FieldLogger
+		mux      sync.Mutex
+	}
+)
+
+func NewRecorder(baseRepo core.StatsRepo, timegen TimeGenerator, cfg RecorderConfig, logger logrus.FieldLogger) (*Recorder, error) {
+	if lo.IsNil(baseRepo) {
+		return nil, errors.New("base repo is nil")
+	}
+	if lo.IsNil(timegen) {
+		return nil, errors.New("time generator is nil")
+	}
+	if lo.IsNil(logger) {
+		return nil, errors.New("logger is nil")
+	}
+	if cfg.Path == "" {
+		return nil, errors.New("recording path is empty")
+	}
+
+	file, err := os.OpenFile(cfg.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644) //nolint:gosec // the recording is not a secret
+	if err != nil {
+		return nil, fmt.Errorf("open recording file: %w", err)
+	}
+
+	return &Recorder{
+		baseRepo: baseRepo,
+		timegen:  timegen,
+		file:     file,
+		encoder:  json.NewEncoder(file),
+		logger:   logger,
+	}, nil
+}
+
+// GetSensorsByHardware reads and records the whole snapshot, the filter is applied only to the result,
+// so a filtered read doesn't leave a partial snapshot in the recording.
+func (r *Recorder) GetSensorsByHardware(ctx context.Context, filter core.StatsFilter) (map[core.Hardware][]core.Sensor, error) {
+	sensorsByHardware, err := r.baseRepo.GetSensorsByHardware(ctx, core.StatsFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	// the recording is a diagnostic, its failure doesn't fail the read
+	if err = r.record(fromCore(r.timegen.Now(), sensorsByHardware)); err != nil {
+		r.logger.Errorf("record snapshot: %s", err)
+	}
+
+	if filter.IsEmpty() {
+		return sensorsByHardware, nil
+	}
+
+	return filter.Apply(sensorsByHardware), nil
+}
+
+// Shutdown closes the recording file, it is called by the injector on the shutdown of the service.
+func (r *Recorder) Shutdown() error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.file.Close()
+}
+
+func (r *Recorder) record(s snapshot) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	// the file is not buffered, so every snapshot survives the crash of the service
+	return r.encoder.Encode(s)
+}
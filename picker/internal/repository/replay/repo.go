@@ -0,0 +1,144 @@
+// Package replay records the snapshots read by picker and serves them back as a sensor backend.
+// It allows to reproduce an incident captured on a real machine on any other one, e.g. in CI.
+package replay
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/samber/lo"
+)
+
+type (
+	Config struct {
+		// Path is the NDJSON file written by the Recorder.
+		Path string `envconfig:"APP_REPLAY_PATH"`
+		// Speed is the multiplier of the replay speed, e.g. 2 replays one hour of the recording in 30 minutes.
+		Speed float64 `envconfig:"APP_REPLAY_SPEED" default:"1"`
+		// Loop restarts the recording when it is over, otherwise the last snapshot is served forever.
+		Loop bool `envconfig:"APP_REPLAY_LOOP" default:"false"`
+	}
+
+	Repo struct {
+		timegen   TimeGenerator
+		startedAt time.Time
+		speed     float64
+		loop      bool
+		snapshots []snapshot
+	}
+)
+
+// maxLineSize limits the size of a single snapshot in the recording.
+const maxLineSize = 16 << 20
+
+func NewRepo(cfg Config, timegen TimeGenerator) (*Repo, error) {
+	if lo.IsNil(timegen) {
+		return nil, errors.New("time generator is nil")
+	}
+	if cfg.Path == "" {
+		return nil, errors.New("replay path is empty")
+	}
+	if cfg.Speed <= 0 {
+		return nil, errors.New("replay speed must be greater than 0")
+	}
+
+	snapshots, err := readSnapshots(cfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("read recording: %w", err)
+	}
+
+	return &Repo{
+		timegen:   timegen,
+		startedAt: timegen.Now(),
+		speed:     cfg.Speed,
+		loop:      cfg.Loop,
+		snapshots: snapshots,
+	}, nil
+}
+
+func (r *Repo) GetHardware(_ context.Context, _ core.StatsFilter) ([]core.Hardware, error) {
+	return r.current(r.timegen.Now()).toCoreHardware(), nil
+}
+
+func (r *Repo) GetSensors(_ context.Context, _ core.StatsFilter) ([]core.Sensor, error) {
+	now := r.timegen.Now()
+
+	return r.current(now).toCoreSensors(now), nil
+}
+
+// GetSnapshot resolves the snapshot once, so the hardware and sensors are never taken from the different ones.
+func (r *Repo) GetSnapshot(_ context.Context, _ core.StatsFilter) ([]core.Hardware, []core.Sensor, error) {
+	now := r.timegen.Now()
+	current := r.current(now)
+
+	return current.toCoreHardware(), current.toCoreSensors(now), nil
+}
+
+// current returns the last snapshot recorded before the moment of the recording that corresponds to now.
+func (r *Repo) current(now time.Time) snapshot {
+	var (
+		first  = r.snapshots[0].Timestamp
+		last   = r.snapshots[len(r.snapshots)-1].Timestamp
+		offset = time.Duration(float64(now.Sub(r.startedAt)) * r.speed)
+	)
+
+	// the last snapshot is served as long as the interval before it, only then the recording restarts
+	if r.loop && len(r.snapshots) > 1 {
+		lastInterval := last.Sub(r.snapshots[len(r.snapshots)-2].Timestamp)
+		if period := last.Sub(first) + lastInterval; period > 0 {
+			offset %= period
+		}
+	}
+
+	target := first.Add(offset)
+	idx := sort.Search(len(r.snapshots), func(i int) bool {
+		return r.snapshots[i].Timestamp.After(target)
+	})
+
+	return r.snapshots[max(idx-1, 0)]
+}
+
+func readSnapshots(path string) ([]snapshot, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var snapshots []snapshot
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var s snapshot
+		if err = json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			return nil, fmt.Errorf("unmarshal line %d: %w", line, err)
+		}
+		snapshots = append(snapshots, s)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	if len(snapshots) == 0 {
+		return nil, errors.New("recording is empty")
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Timestamp.Before(snapshots[j].Timestamp)
+	})
+
+	return snapshots, nil
+}
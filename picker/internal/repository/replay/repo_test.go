@@ -0,0 +1,177 @@
+package replay_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/replay"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+
+	var (
+		path  = filepath.Join(t.TempDir(), "recording.ndjson")
+		start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		clock = &testClock{now: start}
+		base  = &testStatsRepo{}
+	)
+
+	recorder, err := replay.NewRecorder(base, clock, replay.RecorderConfig{Path: path}, logrus.New())
+	require.NoError(t, err)
+
+	for _, value := range []float64{10.5, 20.25, 30} {
+		base.value = value
+		_, err = recorder.GetSensorsByHardware(context.Background(), core.StatsFilter{})
+		require.NoError(t, err)
+		clock.now = clock.now.Add(10 * time.Second)
+	}
+	require.NoError(t, recorder.Shutdown())
+
+	tests := map[string]struct {
+		cfg  replay.Config
+		want map[time.Duration]float64
+	}{
+		"normal speed": {
+			cfg:  replay.Config{Path: path, Speed: 1},
+			want: map[time.Duration]float64{0: 10.5, 9 * time.Second: 10.5, 10 * time.Second: 20.25, 25 * time.Second: 30, time.Hour: 30},
+		},
+		"double speed": {
+			cfg:  replay.Config{Path: path, Speed: 2},
+			want: map[time.Duration]float64{0: 10.5, 5 * time.Second: 20.25, 10 * time.Second: 30},
+		},
+		"loop": {
+			cfg: replay.Config{Path: path, Speed: 1, Loop: true},
+			// the last snapshot is served for the interval before it, as the other ones
+			want: map[time.Duration]float64{
+				15 * time.Second: 20.25, 25 * time.Second: 30, 29 * time.Second: 30, 30 * time.Second: 10.5, 45 * time.Second: 20.25,
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			replayClock := &testClock{now: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)}
+			startedAt := replayClock.now
+
+			repo, err := replay.NewRepo(test.cfg, replayClock)
+			require.NoError(t, err)
+
+			hardware, err := repo.GetHardware(context.Background(), core.StatsFilter{})
+			require.NoError(t, err)
+			require.Equal(t, []core.Hardware{testHardware}, hardware)
+
+			for elapsed, want := range test.want {
+				replayClock.now = startedAt.Add(elapsed)
+				hardware, sensors, err := repo.GetSnapshot(context.Background(), core.StatsFilter{})
+				require.NoError(t, err)
+				require.Equal(t, []core.Hardware{testHardware}, hardware)
+				require.Len(t, sensors, 1)
+				require.Equal(t, want, sensors[0].Value.Value, "elapsed %s", elapsed)
+				require.Equal(t, replayClock.now, sensors[0].Value.Timestamp)
+				require.Equal(t, core.Temperature, sensors[0].Type)
+				require.Equal(t, 1, sensors[0].Index)
+				require.InDelta(t, 5.5, sensors[0].Value.Min, 0)
+				require.InDelta(t, 90.0, sensors[0].Value.Max, 0)
+			}
+		})
+	}
+}
+
+func TestRecorderFilteredRead(t *testing.T) {
+	t.Parallel()
+
+	var (
+		path = filepath.Join(t.TempDir(), "recording.ndjson")
+		base = &testStatsRepo{value: 42}
+	)
+
+	recorder, err := replay.NewRecorder(base, &testClock{}, replay.RecorderConfig{Path: path}, logrus.New())
+	require.NoError(t, err)
+
+	got, err := recorder.GetSensorsByHardware(context.Background(), core.StatsFilter{SensorTypes: []core.SensorType{core.Load}})
+	require.NoError(t, err)
+	require.Equal(t, map[core.Hardware][]core.Sensor{testHardware: nil}, got)
+	require.NoError(t, recorder.Shutdown())
+	require.Equal(t, []core.StatsFilter{{}}, base.filters)
+
+	// the recording has the sensor filtered out of the read
+	repo, err := replay.NewRepo(replay.Config{Path: path, Speed: 1}, &testClock{})
+	require.NoError(t, err)
+	sensors, err := repo.GetSensors(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Len(t, sensors, 1)
+	require.InDelta(t, 42.0, sensors[0].Value.Value, 0)
+}
+
+func TestRecorderWriteFailure(t *testing.T) {
+	t.Parallel()
+
+	recorder, err := replay.NewRecorder(
+		&testStatsRepo{value: 10}, &testClock{},
+		replay.RecorderConfig{Path: filepath.Join(t.TempDir(), "recording.ndjson")}, logrus.New(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, recorder.Shutdown())
+
+	// the file is closed, so the snapshot isn't recorded, but it is still returned
+	got, err := recorder.GetSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Len(t, got[testHardware], 1)
+}
+
+func TestNewRepoInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]replay.Config{
+		"empty path":   {Speed: 1},
+		"zero speed":   {Path: filepath.Join(t.TempDir(), "recording.ndjson")},
+		"missing file": {Path: filepath.Join(t.TempDir(), "missing.ndjson"), Speed: 1},
+	}
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := replay.NewRepo(cfg, &testClock{})
+			require.Error(t, err)
+		})
+	}
+}
+
+var testHardware = core.Hardware{ID: "/intelcpu/0", Name: "Test CPU", Type: core.CPU}
+
+type testStatsRepo struct {
+	value float64
+	// filters are the filters of the reads
+	filters []core.StatsFilter
+}
+
+func (r *testStatsRepo) GetSensorsByHardware(_ context.Context, filter core.StatsFilter) (map[core.Hardware][]core.Sensor, error) {
+	r.filters = append(r.filters, filter)
+	return map[core.Hardware][]core.Sensor{
+		testHardware: {
+			{
+				ID:         "/intelcpu/0/temperature/0",
+				HardwareID: testHardware.ID,
+				Name:       "CPU Package",
+				Type:       core.Temperature,
+				Index:      1,
+				Value:      core.SensorValue{Value: r.value, Min: 5.5, Max: 90},
+			},
+		},
+	}, nil
+}
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
@@ -3,6 +3,7 @@ package stats
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -10,70 +11,210 @@ import (
 	"github.com/samber/lo"
 )
 
-type CachedRepoConfig struct {
-	Retention time.Duration `envconfig:"APP_STATS_CACHE_RETENTION" default:"1s"`
-}
+type (
+	CachedRepoConfig struct {
+		// Retention is the age the stats are served at without refreshing them.
+		Retention time.Duration `envconfig:"APP_STATS_CACHE_RETENTION" default:"1s"`
+		// MaxStaleness is the age the stats are served at while a single refresh runs in the background,
+		// the older stats are refreshed before responding.
+		MaxStaleness time.Duration `envconfig:"APP_STATS_CACHE_MAX_STALENESS" default:"10s" validate:"gtefield=Retention"`
+		// MaxFallbackAge is the age the stats are served at when the refresh fails, the error is returned beyond it.
+		MaxFallbackAge time.Duration `envconfig:"APP_STATS_CACHE_MAX_FALLBACK_AGE" default:"1m" validate:"gtefield=MaxStaleness"`
+		// RefreshTimeout bounds the refresh, it doesn't depend on the request that started it.
+		RefreshTimeout time.Duration `envconfig:"APP_STATS_CACHE_REFRESH_TIMEOUT" default:"10s" validate:"gt=0"`
+	}
+
+	TimeGenerator interface {
+		Now() time.Time
+	}
 
+	// CacheReporter counts the lookups by the result and the refreshes.
+	CacheReporter interface {
+		ReportCacheLookup(result string)
+		ReportCacheRefresh(err error)
+	}
+)
+
+// The results of the cache lookups.
+const (
+	CacheHit = "hit"
+	// CacheStale is the stale stats served while they are refreshed in the background.
+	CacheStale = "stale"
+	// CacheMiss is the request waiting for the refresh.
+	CacheMiss = "miss"
+	// CacheFallback is the stale stats served because the refresh failed.
+	CacheFallback = "fallback"
+)
+
+// CachedRepo serves the stats read earlier while they are refreshed in the background (stale-while-revalidate),
+// so the requests don't wait for the slow source. The mutex is never held while the base repo is read.
 type CachedRepo struct {
 	baseRepo core.StatsRepo
-	cache    cache
-	mux      *sync.Mutex
+	timegen  TimeGenerator
+	reporter CacheReporter
+	cfg      CachedRepoConfig
+
+	mux *sync.Mutex
+	// entries keeps the stats read with each filter separately, the key is the filter key
+	entries map[string]*entry
 }
 
-func NewCachedRepo(baseRepo core.StatsRepo, cfg CachedRepoConfig) (*CachedRepo, error) {
+// entry is the stats read with a single filter.
+type entry struct {
+	stats map[core.Hardware][]core.Sensor
+	// updatedAt is the time of the last successful refresh, it is zero until the stats are read once
+	updatedAt time.Time
+	// accessedAt is the time of the last request, the entries not requested for MaxStaleness are dropped
+	accessedAt time.Time
+	// refreshing is set while a refresh runs
+	refreshing *refresh
+}
+
+type refresh struct {
+	done chan struct{}
+	err  error
+}
+
+func NewCachedRepo(baseRepo core.StatsRepo, timegen TimeGenerator, reporter CacheReporter, cfg CachedRepoConfig) (*CachedRepo, error) {
 	if lo.IsNil(baseRepo) {
 		return nil, errors.New("base repo is nil")
 	}
+	if lo.IsNil(timegen) {
+		return nil, errors.New("time generator is nil")
+	}
+	if lo.IsNil(reporter) {
+		return nil, errors.New("cache reporter is nil")
+	}
+	if cfg.MaxStaleness < cfg.Retention {
+		return nil, errors.New("max staleness must not be less than retention")
+	}
+	if cfg.MaxFallbackAge < cfg.MaxStaleness {
+		return nil, errors.New("max fallback age must not be less than max staleness")
+	}
+	if cfg.RefreshTimeout <= 0 {
+		return nil, errors.New("refresh timeout must be positive")
+	}
 
 	return &CachedRepo{
 		baseRepo: baseRepo,
-		cache:    newCache(cfg.Retention),
+		timegen:  timegen,
+		reporter: reporter,
+		cfg:      cfg,
 		mux:      &sync.Mutex{},
+		entries:  make(map[string]*entry),
 	}, nil
 }
 
-// todo: implement a test for this method, try to use RWMutex instead of Mutex
-func (c *CachedRepo) GetSensorsByHardware(ctx context.Context) (map[core.Hardware][]core.Sensor, error) {
-	c.mux.Lock()
-	defer c.mux.Unlock()
+func (c *CachedRepo) GetSensorsByHardware(ctx context.Context, filter core.StatsFilter) (map[core.Hardware][]core.Sensor, error) {
+	stats, _, err := c.GetCachedSensorsByHardware(ctx, filter)
+	return stats, err
+}
+
+// GetCachedSensorsByHardware returns the stats along with their age:
+//   - the stats younger than the retention are returned as they are;
+//   - the stats younger than the max staleness are returned as stale, a background refresh is started
+//     unless one is running already;
+//   - otherwise the request waits for the refresh. If it fails, the last good stats are returned as stale
+//     unless they are older than the max fallback age.
+func (c *CachedRepo) GetCachedSensorsByHardware(
+	ctx context.Context,
+	filter core.StatsFilter,
+) (map[core.Hardware][]core.Sensor, core.Freshness, error) {
+	key := filter.Key()
 
-	if c.cache.isValid() {
-		return c.cache.get(), nil
+	c.mux.Lock()
+	now := c.timegen.Now()
+	e, ok := c.entries[key]
+	if !ok {
+		e = &entry{}
+		c.entries[key] = e
+	}
+	e.accessedAt = now
+
+	if !e.updatedAt.IsZero() {
+		age := now.Sub(e.updatedAt)
+		if age < c.cfg.Retention {
+			defer c.mux.Unlock()
+			c.reporter.ReportCacheLookup(CacheHit)
+			return e.stats, core.Freshness{Age: age}, nil
+		}
+		if age < c.cfg.MaxStaleness {
+			defer c.mux.Unlock()
+			c.reporter.ReportCacheLookup(CacheStale)
+			c.startRefresh(ctx, key, filter, e)
+			return e.stats, core.Freshness{Age: age, Stale: true}, nil
+		}
 	}
 
-	sensorsByHardware, err := c.baseRepo.GetSensorsByHardware(ctx)
-	if err != nil {
-		return nil, err
+	r := c.startRefresh(ctx, key, filter, e)
+	c.mux.Unlock()
+	c.reporter.ReportCacheLookup(CacheMiss)
+
+	select {
+	case <-ctx.Done():
+		return nil, core.Freshness{}, ctx.Err()
+	case <-r.done:
 	}
 
-	c.cache.set(sensorsByHardware)
+	c.mux.Lock()
+	defer c.mux.Unlock()
 
-	return c.cache.get(), nil
-}
+	if e.updatedAt.IsZero() {
+		return nil, core.Freshness{}, r.err
+	}
 
-type cache struct {
-	stats     map[core.Hardware][]core.Sensor
-	retention time.Duration
-	updatedAt time.Time
+	age := c.timegen.Now().Sub(e.updatedAt)
+	if r.err != nil {
+		if age > c.cfg.MaxFallbackAge {
+			return nil, core.Freshness{}, r.err
+		}
+		c.reporter.ReportCacheLookup(CacheFallback)
+	}
+
+	return e.stats, core.Freshness{Age: age, Stale: r.err != nil || age >= c.cfg.Retention}, nil
 }
 
-func newCache(retention time.Duration) cache {
-	return cache{
-		stats:     make(map[core.Hardware][]core.Sensor),
-		retention: retention,
-		updatedAt: time.Time{},
+// startRefresh starts reading the stats unless a refresh is running already, it returns the running one.
+// The refresh outlives the request, so the other requests may wait for it. The caller holds the mutex.
+func (c *CachedRepo) startRefresh(ctx context.Context, key string, filter core.StatsFilter, e *entry) *refresh {
+	if e.refreshing != nil {
+		return e.refreshing
 	}
-}
 
-func (c *cache) isValid() bool {
-	return time.Since(c.updatedAt) < c.retention
-}
+	r := &refresh{done: make(chan struct{})}
+	e.refreshing = r
+
+	go func() {
+		refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.cfg.RefreshTimeout)
+		defer cancel()
 
-func (c *cache) set(sensorsByHardware map[core.Hardware][]core.Sensor) {
-	c.stats = sensorsByHardware
-	c.updatedAt = time.Now()
+		stats, err := c.baseRepo.GetSensorsByHardware(refreshCtx, filter)
+		c.reporter.ReportCacheRefresh(err)
+
+		c.mux.Lock()
+		defer c.mux.Unlock()
+
+		now := c.timegen.Now()
+		if err != nil {
+			r.err = fmt.Errorf("refresh stats: %w", err)
+		} else {
+			e.stats = stats
+			e.updatedAt = now
+		}
+		e.refreshing = nil
+		close(r.done)
+
+		c.evict(key, now)
+	}()
+
+	return r
 }
 
-func (c *cache) get() map[core.Hardware][]core.Sensor {
-	return c.stats
+// evict drops the entries of the filters that are not requested anymore. The caller holds the mutex.
+func (c *CachedRepo) evict(keep string, now time.Time) {
+	for key, e := range c.entries {
+		if key != keep && e.refreshing == nil && now.Sub(e.accessedAt) >= c.cfg.MaxStaleness {
+			delete(c.entries, key)
+		}
+	}
 }
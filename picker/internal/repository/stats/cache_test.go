@@ -0,0 +1,341 @@
+package stats_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/stats"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testCacheConfig = stats.CachedRepoConfig{
+		Retention:      time.Second,
+		MaxStaleness:   10 * time.Second,
+		MaxFallbackAge: time.Minute,
+		RefreshTimeout: time.Second,
+	}
+	cachedCPU = core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+)
+
+func TestCachedRepoServesFreshStats(t *testing.T) {
+	t.Parallel()
+
+	var (
+		clock    = newFakeClock()
+		base     = newFakeBaseRepo()
+		reporter = &fakeCacheReporter{}
+	)
+	repo, err := stats.NewCachedRepo(base, clock, reporter, testCacheConfig)
+	require.NoError(t, err)
+
+	got, freshness, err := repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t, statsOf(1), got)
+	require.Equal(t, core.Freshness{}, freshness)
+
+	clock.advance(500 * time.Millisecond)
+
+	got, freshness, err = repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t, statsOf(1), got)
+	require.Equal(t, core.Freshness{Age: 500 * time.Millisecond}, freshness)
+	require.Equal(t, 1, base.calls())
+	require.Equal(t, []string{stats.CacheMiss, stats.CacheHit}, reporter.lookups())
+
+	// the filters are cached separately
+	_, _, err = repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{SensorTypes: []core.SensorType{core.Load}})
+	require.NoError(t, err)
+	require.Equal(t, 2, base.calls())
+}
+
+func TestCachedRepoServesStaleWhileRefreshing(t *testing.T) {
+	t.Parallel()
+
+	var (
+		clock = newFakeClock()
+		base  = newFakeBaseRepo()
+	)
+	repo, err := stats.NewCachedRepo(base, clock, &fakeCacheReporter{}, testCacheConfig)
+	require.NoError(t, err)
+
+	_, _, err = repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+
+	// the refresh is blocked, the stale stats are served without waiting for it
+	base.block()
+	clock.advance(2 * time.Second)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			got, freshness, err := repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{})
+			require.NoError(t, err)
+			require.Equal(t, statsOf(1), got)
+			require.Equal(t, core.Freshness{Age: 2 * time.Second, Stale: true}, freshness)
+		}()
+	}
+	wg.Wait()
+
+	// only a single refresh is started for all the requests
+	base.unblock()
+	require.Eventually(t, func() bool {
+		got, freshness, err := repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{})
+		return err == nil && !freshness.Stale && got[cachedCPU][0].Value.Value == 2
+	}, time.Second, time.Millisecond)
+	require.Equal(t, 2, base.calls())
+}
+
+func TestCachedRepoWaitsForRefreshBeyondMaxStaleness(t *testing.T) {
+	t.Parallel()
+
+	var (
+		clock = newFakeClock()
+		base  = newFakeBaseRepo()
+	)
+	repo, err := stats.NewCachedRepo(base, clock, &fakeCacheReporter{}, testCacheConfig)
+	require.NoError(t, err)
+
+	_, _, err = repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+
+	clock.advance(testCacheConfig.MaxStaleness)
+
+	got, freshness, err := repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t, statsOf(2), got)
+	require.Equal(t, core.Freshness{}, freshness)
+}
+
+func TestCachedRepoFallsBackToLastGoodStats(t *testing.T) {
+	t.Parallel()
+
+	var (
+		clock    = newFakeClock()
+		base     = newFakeBaseRepo()
+		reporter = &fakeCacheReporter{}
+	)
+	repo, err := stats.NewCachedRepo(base, clock, reporter, testCacheConfig)
+	require.NoError(t, err)
+
+	// nothing to fall back to yet
+	base.fail(errors.New("wmi is down"))
+	_, _, err = repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.ErrorContains(t, err, "wmi is down")
+
+	base.fail(nil)
+	_, _, err = repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+
+	base.fail(errors.New("wmi is down"))
+	clock.advance(time.Minute)
+
+	got, freshness, err := repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t, statsOf(1), got)
+	require.Equal(t, core.Freshness{Age: time.Minute, Stale: true}, freshness)
+	require.Equal(t, []string{stats.CacheMiss, stats.CacheMiss, stats.CacheMiss, stats.CacheFallback}, reporter.lookups())
+	require.Equal(t, 2, reporter.failedRefreshes())
+
+	// the stats older than the max fallback age are not served as if they were live
+	clock.advance(time.Second)
+
+	_, _, err = repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.ErrorContains(t, err, "wmi is down")
+	require.Equal(t, stats.CacheMiss, reporter.lookups()[len(reporter.lookups())-1])
+}
+
+func TestCachedRepoRequestCanceledWhileWaiting(t *testing.T) {
+	t.Parallel()
+
+	base := newFakeBaseRepo()
+	repo, err := stats.NewCachedRepo(base, newFakeClock(), &fakeCacheReporter{}, testCacheConfig)
+	require.NoError(t, err)
+
+	base.block()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err = repo.GetCachedSensorsByHardware(ctx, core.StatsFilter{})
+	require.ErrorIs(t, err, context.Canceled)
+
+	// the refresh outlives the canceled request, the next request gets its result
+	base.unblock()
+	got, _, err := repo.GetCachedSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t, statsOf(1), got)
+	require.Equal(t, 1, base.calls())
+}
+
+func TestNewCachedRepoInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]stats.CachedRepoConfig{
+		"max staleness less than retention": {
+			Retention:      time.Minute,
+			MaxStaleness:   time.Second,
+			MaxFallbackAge: time.Minute,
+			RefreshTimeout: time.Second,
+		},
+		"max fallback age less than max staleness": {
+			Retention:      time.Second,
+			MaxStaleness:   time.Minute,
+			MaxFallbackAge: time.Second,
+			RefreshTimeout: time.Second,
+		},
+		"no refresh timeout": {
+			Retention:      time.Second,
+			MaxStaleness:   time.Second,
+			MaxFallbackAge: time.Second,
+		},
+	}
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := stats.NewCachedRepo(newFakeBaseRepo(), newFakeClock(), &fakeCacheReporter{}, cfg)
+			require.Error(t, err)
+		})
+	}
+}
+
+func statsOf(value float64) map[core.Hardware][]core.Sensor {
+	return map[core.Hardware][]core.Sensor{
+		cachedCPU: {{ID: "/intelcpu/0/load/0", HardwareID: cachedCPU.ID, Type: core.Load, Value: core.SensorValue{Value: value}}},
+	}
+}
+
+type fakeClock struct {
+	mux sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+type fakeCacheReporter struct {
+	mux      sync.Mutex
+	results  []string
+	failures int
+}
+
+func (r *fakeCacheReporter) ReportCacheLookup(result string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.results = append(r.results, result)
+}
+
+func (r *fakeCacheReporter) ReportCacheRefresh(err error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if err != nil {
+		r.failures++
+	}
+}
+
+func (r *fakeCacheReporter) lookups() []string {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return slices.Clone(r.results)
+}
+
+func (r *fakeCacheReporter) failedRefreshes() int {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.failures
+}
+
+// fakeBaseRepo returns the stats whose value is the number of the successful reads.
+// The reads wait while it is blocked.
+type fakeBaseRepo struct {
+	mux   sync.Mutex
+	reads int
+	n     int
+	err   error
+	gate  chan struct{}
+}
+
+func newFakeBaseRepo() *fakeBaseRepo {
+	gate := make(chan struct{})
+	close(gate)
+
+	return &fakeBaseRepo{gate: gate}
+}
+
+func (r *fakeBaseRepo) GetSensorsByHardware(ctx context.Context, _ core.StatsFilter) (map[core.Hardware][]core.Sensor, error) {
+	r.mux.Lock()
+	gate := r.gate
+	r.mux.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-gate:
+	}
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.n++
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.reads++
+
+	return statsOf(float64(r.reads)), nil
+}
+
+func (r *fakeBaseRepo) calls() int {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.n
+}
+
+func (r *fakeBaseRepo) fail(err error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.err = err
+}
+
+func (r *fakeBaseRepo) block() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.gate = make(chan struct{})
+}
+
+func (r *fakeBaseRepo) unblock() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	close(r.gate)
+}
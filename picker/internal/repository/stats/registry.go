@@ -0,0 +1,89 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type (
+	// Backend is the name of a Source implementation.
+	Backend string
+
+	Config struct {
+		Backend Backend `envconfig:"APP_STATS_BACKEND" default:"wmi" validate:"oneof=wmi hwmon synthetic replay"`
+	}
+
+	SourceFactory func() (Source, error)
+
+	// Registry keeps the backends available on the current platform.
+	// The factories are invoked lazily, so only the chosen backend is initialized.
+	Registry struct {
+		factories map[Backend]SourceFactory
+	}
+)
+
+const (
+	// WMIBackend reads LibreHardwareMonitor over WMI, available only on Windows.
+	WMIBackend Backend = "wmi"
+	// HwmonBackend reads the hwmon sysfs interface, available only on Linux.
+	HwmonBackend Backend = "hwmon"
+	// SyntheticBackend generates the readings from a scenario, available on any platform.
+	SyntheticBackend Backend = "synthetic"
+	// ReplayBackend serves the snapshots recorded earlier, available on any platform.
+	ReplayBackend Backend = "replay"
+)
+
+var ErrBackendNotAvailable = errors.New("stats backend is not available")
+
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[Backend]SourceFactory),
+	}
+}
+
+func (r *Registry) Register(backend Backend, factory SourceFactory) error {
+	if backend == "" {
+		return errors.New("backend name is empty")
+	}
+	if factory == nil {
+		return fmt.Errorf("factory for backend %s is nil", backend)
+	}
+	if _, ok := r.factories[backend]; ok {
+		return fmt.Errorf("backend %s is already registered", backend)
+	}
+
+	r.factories[backend] = factory
+
+	return nil
+}
+
+func (r *Registry) NewSource(backend Backend) (Source, error) {
+	factory, ok := r.factories[backend]
+	if !ok {
+		return nil, fmt.Errorf(
+			"%w: %s on %s, available backends: [%s]",
+			ErrBackendNotAvailable, backend, runtime.GOOS, strings.Join(r.Backends(), ", "),
+		)
+	}
+
+	source, err := factory()
+	if err != nil {
+		return nil, fmt.Errorf("create %s source: %w", backend, err)
+	}
+
+	return source, nil
+}
+
+// Backends returns the sorted names of the registered backends.
+func (r *Registry) Backends() []string {
+	backends := lo.Map(lo.Keys(r.factories), func(b Backend, _ int) string {
+		return string(b)
+	})
+	slices.Sort(backends)
+	return backends
+}
@@ -1,72 +1,144 @@
 package stats
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/genvmoroz/win-stats/picker/internal/core"
-	"github.com/genvmoroz/win-stats/picker/pkg/ohm"
 	"github.com/samber/lo"
 )
 
-type TimeGenerator interface {
-	Now() time.Time
+// Source is a backend the hardware and sensors are read from, e.g. LibreHardwareMonitor or hwmon.
+// The source may use the filter to read less, the Repo applies the filter to the result anyway.
+type Source interface {
+	GetHardware(ctx context.Context, filter core.StatsFilter) ([]core.Hardware, error)
+	GetSensors(ctx context.Context, filter core.StatsFilter) ([]core.Sensor, error)
 }
 
+// SnapshotSource is a Source that reads the hardware and sensors at once, e.g. the replay of a recording,
+// so both of them come from the same snapshot. The Repo prefers it over the separate reads.
+type SnapshotSource interface {
+	Source
+	GetSnapshot(ctx context.Context, filter core.StatsFilter) ([]core.Hardware, []core.Sensor, error)
+}
+
+// UnknownItemsReporter counts the hardware and sensors of the types unknown to picker,
+// they are returned with the unknown type instead of failing the whole snapshot.
+type UnknownItemsReporter interface {
+	ReportUnknownHardware(rawType string)
+	ReportUnknownSensor(rawType string)
+}
+
+// QueryReporter observes the source queries, so the slow or failing backend can be diagnosed.
+type QueryReporter interface {
+	ObserveQuery(query string, duration time.Duration, err error)
+	ReportSnapshotSize(hardware, sensors int)
+}
+
+// The queries the source is observed by.
+const (
+	hardwareQuery = "hardware"
+	sensorsQuery  = "sensors"
+	snapshotQuery = "snapshot"
+)
+
 type Repo struct {
-	ohm     *ohm.Repo
-	timegen TimeGenerator
+	source        Source
+	reporter      UnknownItemsReporter
+	queryReporter QueryReporter
 }
 
-func NewRepo(timegen TimeGenerator) (*Repo, error) {
-	if lo.IsNil(timegen) {
-		return nil, fmt.Errorf("time generator is nil")
+func NewRepo(source Source, reporter UnknownItemsReporter, queryReporter QueryReporter) (*Repo, error) {
+	if lo.IsNil(source) {
+		return nil, errors.New("source is nil")
+	}
+	if lo.IsNil(reporter) {
+		return nil, errors.New("unknown items reporter is nil")
+	}
+	if lo.IsNil(queryReporter) {
+		return nil, errors.New("query reporter is nil")
 	}
 	return &Repo{
-		ohm:     ohm.NewRepo(),
-		timegen: timegen,
+		source:        source,
+		reporter:      reporter,
+		queryReporter: queryReporter,
 	}, nil
 }
 
-func (r *Repo) GetSensorsByHardware(ctx context.Context) (map[core.Hardware][]core.Sensor, error) {
-	hardware, err := r.getHardware(ctx)
+func (r *Repo) GetSensorsByHardware(ctx context.Context, filter core.StatsFilter) (map[core.Hardware][]core.Sensor, error) {
+	hardware, sensors, err := r.read(ctx, filter)
 	if err != nil {
-		return nil, fmt.Errorf("get hardware: %w", err)
+		return nil, err
 	}
 
-	sensors, err := r.ohm.GetSensors(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("get sensors: %w", err)
-	}
-
-	transformedSensors, err := r.toCoreSensors(sensors)
-	if err != nil {
-		return nil, fmt.Errorf("transform sensors: %w", err)
-	}
+	r.queryReporter.ReportSnapshotSize(len(hardware), len(sensors))
+	r.reportUnknown(hardware, sensors)
 
 	result := make(map[core.Hardware][]core.Sensor, len(hardware))
 	for _, hw := range hardware {
-		for _, sensor := range transformedSensors {
-			if sensor.HardwareID == hw.ID {
+		if !filter.MatchHardware(hw) {
+			continue
+		}
+		// the hardware without sensors is kept, it may be the parent of the hardware with ones
+		result[hw] = nil
+		for _, sensor := range sensors {
+			if sensor.HardwareID == hw.ID && filter.MatchSensor(sensor) {
 				result[hw] = append(result[hw], sensor)
 			}
 		}
 	}
 
+	// keep the order LibreHardwareMonitor shows the sensors in: grouped by type, then by index
+	for _, hwSensors := range result {
+		slices.SortStableFunc(hwSensors, func(a, b core.Sensor) int {
+			return cmp.Or(cmp.Compare(a.Type, b.Type), cmp.Compare(a.Index, b.Index))
+		})
+	}
+
 	return result, nil
 }
 
-func (r *Repo) getHardware(ctx context.Context) ([]core.Hardware, error) {
-	hardware, err := r.ohm.GetHardware(ctx)
+func (r *Repo) read(ctx context.Context, filter core.StatsFilter) ([]core.Hardware, []core.Sensor, error) {
+	if source, ok := r.source.(SnapshotSource); ok {
+		startedAt := time.Now()
+		hardware, sensors, err := source.GetSnapshot(ctx, filter)
+		r.queryReporter.ObserveQuery(snapshotQuery, time.Since(startedAt), err)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get snapshot: %w", err)
+		}
+		return hardware, sensors, nil
+	}
+
+	startedAt := time.Now()
+	hardware, err := r.source.GetHardware(ctx, filter)
+	r.queryReporter.ObserveQuery(hardwareQuery, time.Since(startedAt), err)
 	if err != nil {
-		return nil, fmt.Errorf("get hardware: %w", err)
+		return nil, nil, fmt.Errorf("get hardware: %w", err)
 	}
 
-	transformed, err := r.toCoreHardware(hardware)
+	startedAt = time.Now()
+	sensors, err := r.source.GetSensors(ctx, filter)
+	r.queryReporter.ObserveQuery(sensorsQuery, time.Since(startedAt), err)
 	if err != nil {
-		return nil, fmt.Errorf("transform hardware: %w", err)
+		return nil, nil, fmt.Errorf("get sensors: %w", err)
 	}
 
-	return transformed, nil
+	return hardware, sensors, nil
+}
+
+func (r *Repo) reportUnknown(hardware []core.Hardware, sensors []core.Sensor) {
+	for _, hw := range hardware {
+		if hw.Type == core.UnknownHardwareType {
+			r.reporter.ReportUnknownHardware(hw.RawType)
+		}
+	}
+	for _, sensor := range sensors {
+		if sensor.Type == core.UnknownSensorType {
+			r.reporter.ReportUnknownSensor(sensor.RawType)
+		}
+	}
 }
@@ -0,0 +1,210 @@
+package stats_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/stats"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoGetSensorsByHardwareFilter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		cpu     = core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+		gpu     = core.Hardware{ID: "/gpu-nvidia/0", Type: core.GPU}
+		cpuTemp = core.Sensor{ID: "/intelcpu/0/temperature/0", HardwareID: cpu.ID, Name: "CPU Package", Type: core.Temperature}
+		cpuLoad = core.Sensor{ID: "/intelcpu/0/load/0", HardwareID: cpu.ID, Name: "CPU Total", Type: core.Load}
+		gpuTemp = core.Sensor{ID: "/gpu-nvidia/0/temperature/0", HardwareID: gpu.ID, Name: "GPU Core", Type: core.Temperature}
+	)
+
+	repo, err := stats.NewRepo(&fakeSource{
+		hardware: []core.Hardware{cpu, gpu},
+		sensors:  []core.Sensor{cpuTemp, cpuLoad, gpuTemp},
+	}, &fakeReporter{}, &fakeQueryReporter{})
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		filter core.StatsFilter
+		want   map[core.Hardware][]core.Sensor
+	}{
+		"no filter": {
+			want: map[core.Hardware][]core.Sensor{cpu: {cpuTemp, cpuLoad}, gpu: {gpuTemp}},
+		},
+		"hardware type": {
+			filter: core.StatsFilter{HardwareTypes: []core.HardwareType{core.GPU}},
+			want:   map[core.Hardware][]core.Sensor{gpu: {gpuTemp}},
+		},
+		"sensor type": {
+			filter: core.StatsFilter{SensorTypes: []core.SensorType{core.Temperature}},
+			want:   map[core.Hardware][]core.Sensor{cpu: {cpuTemp}, gpu: {gpuTemp}},
+		},
+		"sensor id and name": {
+			filter: core.StatsFilter{
+				SensorIDs:    []core.SensorID{cpuLoad.ID, gpuTemp.ID},
+				NamePatterns: []string{"cpu*"},
+			},
+			want: map[core.Hardware][]core.Sensor{cpu: {cpuLoad}, gpu: nil},
+		},
+		"hardware id": {
+			filter: core.StatsFilter{HardwareIDs: []core.HardwareID{cpu.ID}},
+			want:   map[core.Hardware][]core.Sensor{cpu: {cpuTemp, cpuLoad}},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := repo.GetSensorsByHardware(context.Background(), test.filter)
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestRepoGetSensorsByHardwareUnknownTypes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		known   = core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+		unknown = core.Hardware{ID: "/quantum/0", Type: core.UnknownHardwareType, RawType: "QPU"}
+		sensors = []core.Sensor{
+			{ID: "/intelcpu/0/temperature/0", HardwareID: known.ID, Type: core.Temperature},
+			{ID: "/intelcpu/0/radiation/0", HardwareID: known.ID, Type: core.UnknownSensorType, RawType: "Radiation"},
+			{ID: "/quantum/0/qubits/0", HardwareID: unknown.ID, Type: core.UnknownSensorType, RawType: "Qubits"},
+		}
+		reporter = &fakeReporter{}
+	)
+
+	repo, err := stats.NewRepo(&fakeSource{hardware: []core.Hardware{known, unknown}, sensors: sensors}, reporter, &fakeQueryReporter{})
+	require.NoError(t, err)
+
+	got, err := repo.GetSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Len(t, got[known], 2)
+	require.Len(t, got[unknown], 1)
+	require.Equal(t, []string{"QPU"}, reporter.hardware)
+	require.Equal(t, []string{"Radiation", "Qubits"}, reporter.sensors)
+}
+
+func TestRepoGetSensorsByHardwareReportsQueries(t *testing.T) {
+	t.Parallel()
+
+	var (
+		source = &fakeSource{
+			hardware: []core.Hardware{{ID: "/intelcpu/0", Type: core.CPU}},
+			sensors:  []core.Sensor{{ID: "/intelcpu/0/load/0", HardwareID: "/intelcpu/0", Type: core.Load}},
+		}
+		reporter = &fakeQueryReporter{}
+	)
+
+	repo, err := stats.NewRepo(source, &fakeReporter{}, reporter)
+	require.NoError(t, err)
+
+	_, err = repo.GetSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]error{"hardware": nil, "sensors": nil}, reporter.queries)
+	require.Equal(t, [2]int{1, 1}, reporter.snapshotSize)
+
+	source.err = errors.New("wmi is down")
+	_, err = repo.GetSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.ErrorContains(t, err, "wmi is down")
+	require.ErrorIs(t, reporter.queries["hardware"], source.err)
+}
+
+func TestRepoGetSensorsByHardwareSnapshotSource(t *testing.T) {
+	t.Parallel()
+
+	var (
+		source = &fakeSnapshotSource{
+			fakeSource: fakeSource{
+				hardware: []core.Hardware{{ID: "/intelcpu/0", Type: core.CPU}},
+				sensors:  []core.Sensor{{ID: "/intelcpu/0/load/0", HardwareID: "/intelcpu/0", Type: core.Load}},
+			},
+		}
+		reporter = &fakeQueryReporter{}
+	)
+
+	repo, err := stats.NewRepo(source, &fakeReporter{}, reporter)
+	require.NoError(t, err)
+
+	got, err := repo.GetSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t,
+		map[core.Hardware][]core.Sensor{
+			{ID: "/intelcpu/0", Type: core.CPU}: {{ID: "/intelcpu/0/load/0", HardwareID: "/intelcpu/0", Type: core.Load}},
+		},
+		got,
+	)
+	require.Equal(t, map[string]error{"snapshot": nil}, reporter.queries)
+
+	source.err = errors.New("recording is broken")
+	_, err = repo.GetSensorsByHardware(context.Background(), core.StatsFilter{})
+	require.ErrorIs(t, err, source.err)
+	require.ErrorIs(t, reporter.queries["snapshot"], source.err)
+}
+
+type fakeQueryReporter struct {
+	queries      map[string]error
+	snapshotSize [2]int
+}
+
+func (r *fakeQueryReporter) ObserveQuery(query string, _ time.Duration, err error) {
+	if r.queries == nil {
+		r.queries = make(map[string]error)
+	}
+	r.queries[query] = err
+}
+
+func (r *fakeQueryReporter) ReportSnapshotSize(hardware, sensors int) {
+	r.snapshotSize = [2]int{hardware, sensors}
+}
+
+type fakeReporter struct {
+	hardware []string
+	sensors  []string
+}
+
+func (r *fakeReporter) ReportUnknownHardware(rawType string) {
+	r.hardware = append(r.hardware, rawType)
+}
+
+func (r *fakeReporter) ReportUnknownSensor(rawType string) {
+	r.sensors = append(r.sensors, rawType)
+}
+
+// fakeSource ignores the filter, the Repo must apply it anyway.
+type fakeSource struct {
+	hardware []core.Hardware
+	sensors  []core.Sensor
+	err      error
+}
+
+func (s *fakeSource) GetHardware(_ context.Context, _ core.StatsFilter) ([]core.Hardware, error) {
+	return s.hardware, s.err
+}
+
+func (s *fakeSource) GetSensors(_ context.Context, _ core.StatsFilter) ([]core.Sensor, error) {
+	return s.sensors, nil
+}
+
+// fakeSnapshotSource fails the separate reads, so the Repo must read the snapshot.
+type fakeSnapshotSource struct {
+	fakeSource
+}
+
+func (s *fakeSnapshotSource) GetHardware(_ context.Context, _ core.StatsFilter) ([]core.Hardware, error) {
+	return nil, errors.New("hardware is read separately")
+}
+
+func (s *fakeSnapshotSource) GetSensors(_ context.Context, _ core.StatsFilter) ([]core.Sensor, error) {
+	return nil, errors.New("sensors are read separately")
+}
+
+func (s *fakeSnapshotSource) GetSnapshot(_ context.Context, _ core.StatsFilter) ([]core.Hardware, []core.Sensor, error) {
+	return s.hardware, s.sensors, s.err
+}
@@ -9,33 +9,45 @@ import (
 	"golang.org/x/sync/singleflight"
 )
 
+// SingleflightReporter counts the reads, shared is true for the concurrent reads that got the result of a single one.
+type SingleflightReporter interface {
+	ReportSingleflightCall(shared bool)
+}
+
 type SingleflightRepo struct {
 	baseRepo core.StatsRepo
+	reporter SingleflightReporter
 	group    singleflight.Group
 }
 
-func NewSingleflightRepo(baseRepo core.StatsRepo) (*SingleflightRepo, error) {
+func NewSingleflightRepo(baseRepo core.StatsRepo, reporter SingleflightReporter) (*SingleflightRepo, error) {
 	if lo.IsNil(baseRepo) {
 		return nil, errors.New("base repo is nil")
 	}
+	if lo.IsNil(reporter) {
+		return nil, errors.New("singleflight reporter is nil")
+	}
 
 	return &SingleflightRepo{
 		baseRepo: baseRepo,
+		reporter: reporter,
 		group:    singleflight.Group{},
 	}, nil
 }
 
 const getSensorsByHardwareKey = "GetSensorsByHardware"
 
-func (c *SingleflightRepo) GetSensorsByHardware(ctx context.Context) (map[core.Hardware][]core.Sensor, error) {
-	result, err, _ := c.group.Do(getSensorsByHardwareKey, func() (any, error) {
+func (c *SingleflightRepo) GetSensorsByHardware(ctx context.Context, filter core.StatsFilter) (map[core.Hardware][]core.Sensor, error) {
+	// only the calls with the same filter share the result
+	result, err, shared := c.group.Do(getSensorsByHardwareKey+":"+filter.Key(), func() (any, error) {
 		var (
 			stats map[core.Hardware][]core.Sensor
 			err   error
 		)
-		stats, err = c.baseRepo.GetSensorsByHardware(ctx)
+		stats, err = c.baseRepo.GetSensorsByHardware(ctx, filter)
 		return stats, err
 	})
+	c.reporter.ReportSingleflightCall(shared)
 	if err != nil {
 		return nil, err
 	}
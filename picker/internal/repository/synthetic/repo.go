@@ -0,0 +1,135 @@
+// Package synthetic generates deterministic sensor readings from a YAML scenario.
+// It is used to demo the dashboards and to test the collectors without real hardware.
+package synthetic
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/samber/lo"
+)
+
+type (
+	Config struct {
+		// ScenarioPath is the path to the YAML scenario, the built-in one is used if it is empty.
+		ScenarioPath string `envconfig:"APP_SYNTHETIC_SCENARIO"`
+		// Seed overrides the seed of the scenario if it is not 0.
+		Seed uint64 `envconfig:"APP_SYNTHETIC_SEED" default:"0"`
+	}
+
+	TimeGenerator interface {
+		Now() time.Time
+	}
+
+	Repo struct {
+		timegen    TimeGenerator
+		startedAt  time.Time
+		seed       uint64
+		resolution time.Duration
+		hardware   []core.Hardware
+		sensors    []*sensor
+
+		// mux guards the random walk state and the observed range of the sensors
+		mux sync.Mutex
+	}
+)
+
+//go:embed scenario.yml
+var defaultScenario []byte
+
+func NewRepo(cfg Config, timegen TimeGenerator) (*Repo, error) {
+	if lo.IsNil(timegen) {
+		return nil, errors.New("time generator is nil")
+	}
+
+	content := defaultScenario
+	if cfg.ScenarioPath != "" {
+		var err error
+		if content, err = os.ReadFile(cfg.ScenarioPath); err != nil {
+			return nil, fmt.Errorf("read scenario: %w", err)
+		}
+	}
+
+	sc, err := parseScenario(content)
+	if err != nil {
+		return nil, fmt.Errorf("parse scenario: %w", err)
+	}
+	if cfg.Seed != 0 {
+		sc.Seed = cfg.Seed
+	}
+
+	repo := &Repo{
+		timegen:    timegen,
+		startedAt:  timegen.Now(),
+		seed:       sc.Seed,
+		resolution: sc.Resolution,
+	}
+	for _, hw := range sc.Hardware {
+		hwType, _ := core.ParseHardwareType(hw.Type) // validated while parsing
+		repo.hardware = append(repo.hardware, core.Hardware{
+			ID:       core.HardwareID(hw.ID),
+			ParentID: core.HardwareID(hw.Parent),
+			Name:     hw.Name,
+			Type:     hwType,
+			Subtype:  hw.Subtype,
+		})
+		// the index is the position among the sensors of the same type, like in LibreHardwareMonitor
+		indexes := make(map[core.SensorType]int)
+		for _, s := range hw.Sensors {
+			sn := newSensor(hw.ID, s)
+			sn.index = indexes[sn.sensorType]
+			indexes[sn.sensorType]++
+			repo.sensors = append(repo.sensors, sn)
+		}
+	}
+
+	return repo, nil
+}
+
+func (r *Repo) GetHardware(_ context.Context, _ core.StatsFilter) ([]core.Hardware, error) {
+	return slices.Clone(r.hardware), nil
+}
+
+func (r *Repo) GetSensors(_ context.Context, _ core.StatsFilter) ([]core.Sensor, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	var (
+		now     = r.timegen.Now()
+		elapsed = now.Sub(r.startedAt)
+		step    = int64(elapsed / r.resolution)
+	)
+
+	out := make([]core.Sensor, 0, len(r.sensors))
+	for _, s := range r.sensors {
+		if !s.presentAt(elapsed) || s.droppedAt(r.seed, step) {
+			continue
+		}
+
+		value := s.valueAt(r.seed, step, r.resolution)
+		minValue, maxValue := s.observe(value)
+
+		out = append(out, core.Sensor{
+			ID:         core.SensorID(s.spec.ID),
+			HardwareID: s.hardwareID,
+			Name:       s.spec.Name,
+			Type:       s.sensorType,
+			Index:      s.index,
+			Value: core.SensorValue{
+				Value:     value,
+				Min:       minValue,
+				Max:       maxValue,
+				Timestamp: now,
+			},
+		})
+	}
+
+	return out, nil
+}
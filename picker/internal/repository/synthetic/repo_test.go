@@ -0,0 +1,179 @@
+package synthetic_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/synthetic"
+	"github.com/stretchr/testify/require"
+)
+
+const testScenario = `
+seed: 7
+resolution: 1s
+hardware:
+  - id: /intelcpu/0
+    name: Test CPU
+    type: CPU
+    sensors:
+      - id: /intelcpu/0/temperature/0
+        name: CPU Package
+        type: Temperature
+        waveform: { kind: sine, min: 40, max: 80, period: 40s }
+      - id: /intelcpu/0/load/0
+        name: CPU Total
+        type: Load
+        waveform: { kind: random-walk, min: 0, max: 100, step: 10 }
+        noise: 2
+        dropout: 0.3
+      - id: /intelcpu/0/clock/0
+        name: CPU Core #1
+        type: Clock
+        waveform: { kind: step, period: 10s, levels: [800, 4000] }
+        appearAt: 5s
+        disappearAt: 15s
+`
+
+func TestRepoGetSensorsIsReproducible(t *testing.T) {
+	t.Parallel()
+
+	path := writeScenario(t, testScenario)
+
+	read := func(seed uint64) [][]core.Sensor {
+		clock := &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+		repo, err := synthetic.NewRepo(synthetic.Config{ScenarioPath: path, Seed: seed}, clock)
+		require.NoError(t, err)
+
+		var snapshots [][]core.Sensor
+		for range 30 {
+			sensors, err := repo.GetSensors(context.Background(), core.StatsFilter{})
+			require.NoError(t, err)
+			snapshots = append(snapshots, sensors)
+			clock.now = clock.now.Add(time.Second)
+		}
+		return snapshots
+	}
+
+	require.Equal(t, read(0), read(0))
+	require.NotEqual(t, read(0), read(1))
+}
+
+func TestRepoGetSensors(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &testClock{now: start}
+
+	repo, err := synthetic.NewRepo(synthetic.Config{ScenarioPath: writeScenario(t, testScenario)}, clock)
+	require.NoError(t, err)
+
+	valueAt := func(elapsed time.Duration, id core.SensorID) (float64, bool) {
+		clock.now = start.Add(elapsed)
+		sensors, err := repo.GetSensors(context.Background(), core.StatsFilter{})
+		require.NoError(t, err)
+		for _, s := range sensors {
+			if s.ID == id {
+				require.Equal(t, clock.now, s.Value.Timestamp)
+				return s.Value.Value, true
+			}
+		}
+		return 0, false
+	}
+
+	const (
+		temperature core.SensorID = "/intelcpu/0/temperature/0"
+		clockSpeed  core.SensorID = "/intelcpu/0/clock/0"
+	)
+
+	for elapsed, want := range map[time.Duration]float64{0: 60, 10 * time.Second: 80, 20 * time.Second: 60, 30 * time.Second: 40} {
+		got, ok := valueAt(elapsed, temperature)
+		require.True(t, ok)
+		require.InDelta(t, want, got, 1e-9, "elapsed %s", elapsed)
+	}
+
+	clock.now = start.Add(31 * time.Second)
+	sensors, err := repo.GetSensors(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	idx := slices.IndexFunc(sensors, func(s core.Sensor) bool { return s.ID == temperature })
+	require.NotEqual(t, -1, idx)
+	require.InDelta(t, 40.0, sensors[idx].Value.Min, 1e-9, "min of the observed values")
+	require.InDelta(t, 80.0, sensors[idx].Value.Max, 1e-9, "max of the observed values")
+
+	_, ok := valueAt(4*time.Second, clockSpeed)
+	require.False(t, ok, "sensor must not appear before appearAt")
+	got, ok := valueAt(5*time.Second, clockSpeed)
+	require.True(t, ok)
+	require.InDelta(t, 800.0, got, 0)
+	got, ok = valueAt(14*time.Second, clockSpeed)
+	require.True(t, ok)
+	require.InDelta(t, 4000.0, got, 0)
+	_, ok = valueAt(15*time.Second, clockSpeed)
+	require.False(t, ok, "sensor must disappear at disappearAt")
+
+	hardware, err := repo.GetHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []core.Hardware{{ID: "/intelcpu/0", Name: "Test CPU", Type: core.CPU}}, hardware)
+}
+
+func TestNewRepoInvalidScenario(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"unknown sensor type": `
+hardware:
+  - { id: /cpu, name: CPU, type: CPU, sensors: [{ id: /cpu/x, name: X, type: Smell, waveform: { kind: constant } }] }`,
+		"unknown waveform": `
+hardware:
+  - { id: /cpu, name: CPU, type: CPU, sensors: [{ id: /cpu/x, name: X, type: Load, waveform: { kind: square } }] }`,
+		"sine without period": `
+hardware:
+  - { id: /cpu, name: CPU, type: CPU, sensors: [{ id: /cpu/x, name: X, type: Load, waveform: { kind: sine, max: 1 } }] }`,
+		"unknown parent": `
+hardware:
+  - { id: /cpu, parent: /board, name: CPU, type: CPU, sensors: [{ id: /cpu/x, name: X, type: Load, waveform: { kind: constant } }] }`,
+		"duplicated sensor": `
+hardware:
+  - { id: /cpu, name: CPU, type: CPU, sensors: [{ id: /cpu/x, name: X, type: Load, waveform: { kind: constant } }] }
+  - { id: /gpu, name: GPU, type: GPU, sensors: [{ id: /cpu/x, name: X, type: Load, waveform: { kind: constant } }] }`,
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := synthetic.NewRepo(synthetic.Config{ScenarioPath: writeScenario(t, content)}, &testClock{})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNewRepoDefaultScenario(t *testing.T) {
+	t.Parallel()
+
+	repo, err := synthetic.NewRepo(synthetic.Config{}, &testClock{now: time.Now()})
+	require.NoError(t, err)
+
+	sensors, err := repo.GetSensors(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.NotEmpty(t, sensors)
+}
+
+func writeScenario(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "scenario.yml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
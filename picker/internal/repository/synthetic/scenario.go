@@ -0,0 +1,160 @@
+package synthetic
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+type (
+	// scenario describes the hardware and the way its sensors change over time.
+	scenario struct {
+		// Seed makes the noise, the dropouts and the random walks reproducible.
+		Seed uint64 `yaml:"seed"`
+		// Resolution is the time step of the scenario, the readings do not change within one step.
+		Resolution time.Duration  `yaml:"resolution" validate:"gte=0"`
+		Hardware   []hardwareSpec `yaml:"hardware" validate:"required,dive"`
+	}
+
+	hardwareSpec struct {
+		ID      string       `yaml:"id" validate:"required"`
+		Parent  string       `yaml:"parent"`
+		Name    string       `yaml:"name" validate:"required"`
+		Type    string       `yaml:"type" validate:"required"`
+		Subtype string       `yaml:"subtype"`
+		Sensors []sensorSpec `yaml:"sensors" validate:"dive"`
+	}
+
+	sensorSpec struct {
+		ID       string       `yaml:"id" validate:"required"`
+		Name     string       `yaml:"name" validate:"required"`
+		Type     string       `yaml:"type" validate:"required"`
+		Waveform waveformSpec `yaml:"waveform"`
+		// Noise is the amplitude of the uniform noise added to every reading.
+		Noise float64 `yaml:"noise" validate:"gte=0"`
+		// Dropout is the probability of the reading to be missing in a single step.
+		Dropout float64 `yaml:"dropout" validate:"gte=0,lte=1"`
+		// AppearAt and DisappearAt limit the time since start the sensor is reported in.
+		AppearAt    time.Duration `yaml:"appearAt" validate:"gte=0"`
+		DisappearAt time.Duration `yaml:"disappearAt" validate:"gte=0"`
+	}
+
+	waveformSpec struct {
+		Kind waveformKind `yaml:"kind" validate:"oneof=constant sine ramp random-walk step"`
+		// Value is the value of the constant waveform.
+		Value float64 `yaml:"value"`
+		// Min and Max bound the sine, ramp and random-walk waveforms.
+		Min float64 `yaml:"min"`
+		Max float64 `yaml:"max"`
+		// Period is the duration of one cycle of the sine and ramp waveforms,
+		// and the duration of one level of the step waveform.
+		Period time.Duration `yaml:"period" validate:"gte=0"`
+		// Step is the maximum change of the random-walk waveform per resolution step.
+		Step float64 `yaml:"step" validate:"gte=0"`
+		// Levels are the values the step waveform cycles through.
+		Levels []float64 `yaml:"levels"`
+	}
+
+	waveformKind string
+)
+
+const (
+	constantWaveform   waveformKind = "constant"
+	sineWaveform       waveformKind = "sine"
+	rampWaveform       waveformKind = "ramp"
+	randomWalkWaveform waveformKind = "random-walk"
+	stepWaveform       waveformKind = "step"
+)
+
+const defaultResolution = time.Second
+
+func parseScenario(content []byte) (scenario, error) {
+	var sc scenario
+	if err := yaml.Unmarshal(content, &sc); err != nil {
+		return scenario{}, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	if sc.Resolution == 0 {
+		sc.Resolution = defaultResolution
+	}
+
+	if err := sc.validate(); err != nil {
+		return scenario{}, fmt.Errorf("validate: %w", err)
+	}
+
+	return sc, nil
+}
+
+func (sc scenario) validate() error {
+	if err := validator.New().Struct(sc); err != nil {
+		return err
+	}
+
+	var errs []error
+
+	hardwareIDs := make(map[string]struct{}, len(sc.Hardware))
+	sensorIDs := make(map[string]struct{})
+	for _, hw := range sc.Hardware {
+		if _, ok := hardwareIDs[hw.ID]; ok {
+			errs = append(errs, fmt.Errorf("duplicated hardware id: %s", hw.ID))
+		}
+		hardwareIDs[hw.ID] = struct{}{}
+
+		if _, err := core.ParseHardwareType(hw.Type); err != nil {
+			errs = append(errs, fmt.Errorf("hardware %s: %w", hw.ID, err))
+		}
+
+		for _, s := range hw.Sensors {
+			if _, ok := sensorIDs[s.ID]; ok {
+				errs = append(errs, fmt.Errorf("duplicated sensor id: %s", s.ID))
+			}
+			sensorIDs[s.ID] = struct{}{}
+
+			if err := s.validate(); err != nil {
+				errs = append(errs, fmt.Errorf("sensor %s: %w", s.ID, err))
+			}
+		}
+	}
+
+	for _, hw := range sc.Hardware {
+		if _, ok := hardwareIDs[hw.Parent]; hw.Parent != "" && !ok {
+			errs = append(errs, fmt.Errorf("hardware %s: unknown parent: %s", hw.ID, hw.Parent))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s sensorSpec) validate() error {
+	if _, err := core.ParseSensorType(s.Type); err != nil {
+		return err
+	}
+	if s.DisappearAt != 0 && s.DisappearAt <= s.AppearAt {
+		return errors.New("disappearAt must be after appearAt")
+	}
+
+	w := s.Waveform
+	switch w.Kind {
+	case constantWaveform:
+	case sineWaveform, rampWaveform, randomWalkWaveform:
+		if w.Min > w.Max {
+			return fmt.Errorf("%s waveform: min must not be greater than max", w.Kind)
+		}
+		if w.Kind != randomWalkWaveform && w.Period <= 0 {
+			return fmt.Errorf("%s waveform: period must be greater than 0", w.Kind)
+		}
+	case stepWaveform:
+		if w.Period <= 0 {
+			return errors.New("step waveform: period must be greater than 0")
+		}
+		if len(w.Levels) == 0 {
+			return errors.New("step waveform: levels are empty")
+		}
+	}
+
+	return nil
+}
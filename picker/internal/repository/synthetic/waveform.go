@@ -0,0 +1,137 @@
+package synthetic
+
+import (
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+)
+
+// sensor is a sensor of the scenario together with its random walk state.
+type sensor struct {
+	spec       sensorSpec
+	hardwareID core.HardwareID
+	sensorType core.SensorType
+	index      int
+	hash       uint64
+	walk       walkState
+	observed   observedRange
+}
+
+// observedRange keeps the lowest and highest values returned so far, the way LibreHardwareMonitor does.
+type observedRange struct {
+	set      bool
+	min, max float64
+}
+
+type walkState struct {
+	step    int64
+	value   float64
+	started bool
+}
+
+func newSensor(hardwareID string, spec sensorSpec) *sensor {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(spec.ID))
+
+	sensorType, _ := core.ParseSensorType(spec.Type) // validated while parsing
+
+	return &sensor{
+		spec:       spec,
+		hardwareID: core.HardwareID(hardwareID),
+		sensorType: sensorType,
+		hash:       h.Sum64(),
+	}
+}
+
+// presentAt reports whether the sensor exists at the given time since the start of the scenario.
+func (s *sensor) presentAt(elapsed time.Duration) bool {
+	if elapsed < s.spec.AppearAt {
+		return false
+	}
+	return s.spec.DisappearAt == 0 || elapsed < s.spec.DisappearAt
+}
+
+// droppedAt reports whether the reading is missing in the given step.
+func (s *sensor) droppedAt(seed uint64, step int64) bool {
+	if s.spec.Dropout == 0 {
+		return false
+	}
+	return s.rand(seed, step, dropoutStream).Float64() < s.spec.Dropout
+}
+
+// valueAt returns the reading in the given step, the resolution is the duration of one step.
+// The random walk is advanced step by step from the last computed one, so it does not depend
+// on how often the sensor is read.
+func (s *sensor) valueAt(seed uint64, step int64, resolution time.Duration) float64 {
+	var (
+		wave    = s.spec.Waveform
+		elapsed = time.Duration(step) * resolution
+		value   float64
+	)
+
+	switch wave.Kind {
+	case constantWaveform:
+		value = wave.Value
+	case sineWaveform:
+		phase := 2 * math.Pi * float64(elapsed) / float64(wave.Period)
+		value = wave.Min + (wave.Max-wave.Min)*(1+math.Sin(phase))/2
+	case rampWaveform:
+		progress := float64(elapsed%wave.Period) / float64(wave.Period)
+		value = wave.Min + (wave.Max-wave.Min)*progress
+	case stepWaveform:
+		level := int64(elapsed/wave.Period) % int64(len(wave.Levels))
+		value = wave.Levels[level]
+	case randomWalkWaveform:
+		value = s.walkTo(seed, step)
+	}
+
+	if s.spec.Noise > 0 {
+		value += (s.rand(seed, step, noiseStream).Float64()*2 - 1) * s.spec.Noise
+	}
+
+	return value
+}
+
+func (s *sensor) walkTo(seed uint64, step int64) float64 {
+	wave := s.spec.Waveform
+
+	if !s.walk.started || step < s.walk.step {
+		s.walk = walkState{
+			step:    0,
+			value:   (wave.Min + wave.Max) / 2,
+			started: true,
+		}
+	}
+
+	for ; s.walk.step < step; s.walk.step++ {
+		delta := (s.rand(seed, s.walk.step+1, walkStream).Float64()*2 - 1) * wave.Step
+		s.walk.value = math.Min(math.Max(s.walk.value+delta, wave.Min), wave.Max)
+	}
+
+	return s.walk.value
+}
+
+// streams separate the random sequences of a sensor, so e.g. enabling noise does not change the dropouts.
+const (
+	noiseStream uint64 = iota + 1
+	dropoutStream
+	walkStream
+)
+
+func (s *sensor) rand(seed uint64, step int64, stream uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed^s.hash, uint64(step)<<2|stream)) //nolint:gosec // reproducibility is required, not security
+}
+
+// observe records the value returned to the client and returns the lowest and highest ones so far.
+func (s *sensor) observe(value float64) (float64, float64) {
+	if !s.observed.set {
+		s.observed = observedRange{set: true, min: value, max: value}
+	}
+	s.observed.min = min(s.observed.min, value)
+	s.observed.max = max(s.observed.max, value)
+
+	return s.observed.min, s.observed.max
+}
@@ -0,0 +1,125 @@
+// Package threshold loads the rules the sensor statuses are judged by from a YAML file.
+package threshold
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"gopkg.in/yaml.v3"
+)
+
+type (
+	Config struct {
+		// Path is the path to the YAML rules, all the sensors are OK if it is empty. See example.yml.
+		Path string `envconfig:"APP_THRESHOLDS_PATH"`
+	}
+
+	rules struct {
+		Rules []ruleSpec `yaml:"rules"`
+	}
+
+	// ruleSpec matches the sensors by all the set fields, the name is a pattern with the '*' and '?' wildcards.
+	// It is checked by toCore, so the error names the rule by its number and the fields by their YAML keys.
+	ruleSpec struct {
+		HardwareType string   `yaml:"hardwareType"`
+		HardwareID   string   `yaml:"hardwareId"`
+		SensorType   string   `yaml:"sensorType"`
+		SensorID     string   `yaml:"sensorId"`
+		Name         string   `yaml:"name"`
+		WarnAbove    *float64 `yaml:"warnAbove"`
+		CritAbove    *float64 `yaml:"critAbove"`
+		WarnBelow    *float64 `yaml:"warnBelow"`
+		CritBelow    *float64 `yaml:"critBelow"`
+	}
+)
+
+// Load reads the rules, the later rule overrides the earlier one matching the same sensor.
+func Load(cfg Config) (core.Thresholds, error) {
+	if cfg.Path == "" {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(cfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("read thresholds: %w", err)
+	}
+
+	thresholds, err := parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("parse thresholds: %w", err)
+	}
+
+	return thresholds, nil
+}
+
+func parse(content []byte) (core.Thresholds, error) {
+	var r rules
+	if err := yaml.Unmarshal(content, &r); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	var (
+		thresholds = make(core.Thresholds, 0, len(r.Rules))
+		errs       []error
+	)
+	for idx, spec := range r.Rules {
+		rule, err := spec.toCore()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %d: %w", idx+1, err))
+			continue
+		}
+		thresholds = append(thresholds, rule)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return thresholds, nil
+}
+
+func (s ruleSpec) toCore() (core.ThresholdRule, error) {
+	if s.WarnAbove == nil && s.CritAbove == nil && s.WarnBelow == nil && s.CritBelow == nil {
+		return core.ThresholdRule{}, errors.New("no bounds")
+	}
+	if s.WarnAbove != nil && s.CritAbove != nil && *s.WarnAbove > *s.CritAbove {
+		return core.ThresholdRule{}, errors.New("warnAbove must not be greater than critAbove")
+	}
+	if s.WarnBelow != nil && s.CritBelow != nil && *s.WarnBelow < *s.CritBelow {
+		return core.ThresholdRule{}, errors.New("warnBelow must not be less than critBelow")
+	}
+
+	var filter core.StatsFilter
+	if s.HardwareType != "" {
+		hwType, err := core.ParseHardwareType(s.HardwareType)
+		if err != nil {
+			return core.ThresholdRule{}, err
+		}
+		filter.HardwareTypes = []core.HardwareType{hwType}
+	}
+	if s.SensorType != "" {
+		sensorType, err := core.ParseSensorType(s.SensorType)
+		if err != nil {
+			return core.ThresholdRule{}, err
+		}
+		filter.SensorTypes = []core.SensorType{sensorType}
+	}
+	if s.HardwareID != "" {
+		filter.HardwareIDs = []core.HardwareID{core.HardwareID(s.HardwareID)}
+	}
+	if s.SensorID != "" {
+		filter.SensorIDs = []core.SensorID{core.SensorID(s.SensorID)}
+	}
+	if s.Name != "" {
+		filter.NamePatterns = []string{s.Name}
+	}
+
+	return core.ThresholdRule{
+		Filter:    filter,
+		WarnAbove: s.WarnAbove,
+		CritAbove: s.CritAbove,
+		WarnBelow: s.WarnBelow,
+		CritBelow: s.CritBelow,
+	}, nil
+}
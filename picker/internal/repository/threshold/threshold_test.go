@@ -0,0 +1,75 @@
+package threshold_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/internal/repository/threshold"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadExample(t *testing.T) {
+	t.Parallel()
+
+	thresholds, err := threshold.Load(threshold.Config{Path: "example.yml"})
+	require.NoError(t, err)
+	require.Len(t, thresholds, 4)
+	require.Equal(t,
+		core.StatsFilter{
+			HardwareTypes: []core.HardwareType{core.CPU},
+			SensorTypes:   []core.SensorType{core.Temperature},
+			NamePatterns:  []string{"CPU Package"},
+		},
+		thresholds[1].Filter,
+	)
+
+	var (
+		cpu  = core.Hardware{ID: "/intelcpu/0", Type: core.CPU}
+		temp = core.Sensor{Name: "CPU Package", Type: core.Temperature, Value: core.SensorValue{Value: 88}}
+	)
+	status, _ := thresholds.Evaluate(cpu, temp)
+	require.Equal(t, core.StatusWarn, status)
+}
+
+func TestLoadNoPath(t *testing.T) {
+	t.Parallel()
+
+	thresholds, err := threshold.Load(threshold.Config{})
+	require.NoError(t, err)
+	require.Empty(t, thresholds)
+}
+
+func TestLoadInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		content string
+		wantErr string
+	}{
+		"no bounds": {
+			content: "rules:\n  - sensorType: Temperature\n",
+			wantErr: "rule 1: no bounds",
+		},
+		"unknown type": {
+			content: "rules:\n  - sensorType: Radiation\n    warnAbove: 80\n",
+			wantErr: "rule 1: unknown sensor type: Radiation",
+		},
+		"warning above critical": {
+			content: "rules:\n  - sensorType: Fan\n    critBelow: 300\n  - sensorType: Temperature\n    warnAbove: 90\n    critAbove: 80\n",
+			wantErr: "rule 2: warnAbove must not be greater than critAbove",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "thresholds.yml")
+			require.NoError(t, os.WriteFile(path, []byte(test.content), 0o600))
+
+			_, err := threshold.Load(threshold.Config{Path: path})
+			require.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
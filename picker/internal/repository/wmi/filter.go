@@ -0,0 +1,58 @@
+package wmi
+
+import (
+	"strings"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/pkg/ohm"
+	"github.com/samber/lo"
+)
+
+// hardwareFilter pushes the filter down to the WMI query, so LibreHardwareMonitor returns less instances.
+func hardwareFilter(filter core.StatsFilter) ohm.HardwareFilter {
+	return func(f *ohm.HardwareFilters) {
+		f.Types = lo.FlatMap(filter.HardwareTypes, func(t core.HardwareType, _ int) []ohm.HardwareType {
+			return fromCoreHardwareType(t)
+		})
+		f.Where = ohm.In("Identifier", toStrings(filter.HardwareIDs)...)
+	}
+}
+
+// sensorFilter pushes the filter down to the WMI query. The hardware types can't be matched by the sensors query,
+// the sensors of the filtered out hardware are dropped when they are grouped by hardware.
+func sensorFilter(filter core.StatsFilter) ohm.SensorFilter {
+	return func(f *ohm.SensorFilters) {
+		f.Types = lo.FilterMap(filter.SensorTypes, func(t core.SensorType, _ int) (ohm.SensorType, bool) {
+			return fromCoreSensorType(t)
+		})
+		f.Where = ohm.And(
+			ohm.In("Identifier", toStrings(filter.SensorIDs)...),
+			ohm.In("Parent", toStrings(filter.HardwareIDs)...),
+			ohm.Or(lo.Map(filter.NamePatterns, func(pattern string, _ int) ohm.Predicate {
+				return ohm.Like("Name", likePattern(pattern))
+			})...),
+		)
+	}
+}
+
+// likePattern converts the '*' and '?' wildcards of the name pattern to the ones of WQL LIKE.
+func likePattern(pattern string) string {
+	var b strings.Builder
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteRune('%')
+		case '?':
+			b.WriteRune('_')
+		default:
+			b.WriteString(ohm.EscapeLike(string(r)))
+		}
+	}
+	return b.String()
+}
+
+func toStrings[T ~string](in []T) []string {
+	return lo.Map(in, func(v T, _ int) string {
+		return string(v)
+	})
+}
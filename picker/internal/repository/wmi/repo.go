@@ -0,0 +1,61 @@
+// Package wmi reads the hardware sensors from LibreHardwareMonitor over WMI.
+package wmi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/pkg/ohm"
+	"github.com/samber/lo"
+)
+
+type (
+	// OHM is implemented by ohm.Repo, which is available only on Windows.
+	OHM interface {
+		GetHardware(ctx context.Context, opts ...ohm.HardwareFilter) ([]ohm.Hardware, error)
+		GetSensors(ctx context.Context, opts ...ohm.SensorFilter) ([]ohm.Sensor, error)
+	}
+
+	TimeGenerator interface {
+		Now() time.Time
+	}
+
+	Repo struct {
+		ohm     OHM
+		timegen TimeGenerator
+	}
+)
+
+func NewRepo(ohm OHM, timegen TimeGenerator) (*Repo, error) {
+	if lo.IsNil(ohm) {
+		return nil, errors.New("ohm repo is nil")
+	}
+	if lo.IsNil(timegen) {
+		return nil, errors.New("time generator is nil")
+	}
+	return &Repo{
+		ohm:     ohm,
+		timegen: timegen,
+	}, nil
+}
+
+func (r *Repo) GetHardware(ctx context.Context, filter core.StatsFilter) ([]core.Hardware, error) {
+	hardware, err := r.ohm.GetHardware(ctx, hardwareFilter(filter))
+	if err != nil {
+		return nil, fmt.Errorf("get hardware: %w", err)
+	}
+
+	return r.toCoreHardware(hardware), nil
+}
+
+func (r *Repo) GetSensors(ctx context.Context, filter core.StatsFilter) ([]core.Sensor, error) {
+	sensors, err := r.ohm.GetSensors(ctx, sensorFilter(filter))
+	if err != nil {
+		return nil, fmt.Errorf("get sensors: %w", err)
+	}
+
+	return r.toCoreSensors(sensors), nil
+}
@@ -0,0 +1,134 @@
+package wmi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/pkg/ohm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoPushesFilterDown(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeOHM{}
+	repo, err := NewRepo(fake, fixedTime(time.Now()))
+	require.NoError(t, err)
+
+	filter := core.StatsFilter{
+		HardwareTypes: []core.HardwareType{core.GPU, core.Motherboard},
+		SensorTypes:   []core.SensorType{core.Temperature, core.Fan},
+		HardwareIDs:   []core.HardwareID{"/gpu-nvidia/0"},
+		SensorIDs:     []core.SensorID{"/gpu-nvidia/0/temperature/0", "/gpu-nvidia/0/fan/1"},
+		NamePatterns:  []string{"GPU ?ore*", "100%_[x]"},
+	}
+
+	_, err = repo.GetHardware(context.Background(), filter)
+	require.NoError(t, err)
+	_, err = repo.GetSensors(context.Background(), filter)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]ohm.HardwareType{ohm.GpuNvidia, ohm.GpuAti, ohm.GpuAmd, ohm.GpuIntel, ohm.Mainboard, ohm.Motherboard},
+		fake.hardwareFilters.Types,
+	)
+	require.Equal(t, "Identifier = '/gpu-nvidia/0'", fake.hardwareFilters.Where.String())
+
+	require.Equal(t, []ohm.SensorType{ohm.Temperature, ohm.Fan}, fake.sensorFilters.Types)
+	require.Equal(t,
+		"(Identifier = '/gpu-nvidia/0/temperature/0' OR Identifier = '/gpu-nvidia/0/fan/1') "+
+			"AND Parent = '/gpu-nvidia/0' "+
+			"AND (Name LIKE 'GPU _ore%' OR Name LIKE '100[%][_][[]x]')",
+		fake.sensorFilters.Where.String(),
+	)
+}
+
+func TestRepoEmptyFilter(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeOHM{}
+	repo, err := NewRepo(fake, fixedTime(time.Now()))
+	require.NoError(t, err)
+
+	_, err = repo.GetHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	_, err = repo.GetSensors(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+
+	require.Equal(t, ohm.HardwareFilters{Types: []ohm.HardwareType{}}, fake.hardwareFilters)
+	require.Equal(t, ohm.SensorFilters{Types: []ohm.SensorType{}}, fake.sensorFilters)
+}
+
+func TestRepoUnknownTypes(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	fake := &fakeOHM{
+		hardware: []ohm.Hardware{
+			{Name: "Intel Core i7", Identifier: "/intelcpu/0", HardwareType: ohm.CPU},
+			{Name: "Quantum", Identifier: "/quantum/0", HardwareType: "Qpu"},
+		},
+		sensors: []ohm.Sensor{
+			{Name: "CPU Package", Identifier: "/intelcpu/0/temperature/0", SensorType: ohm.Temperature, Parent: "/intelcpu/0", Value: 42.5},
+			{Name: "Qubits", Identifier: "/quantum/0/qubits/0", SensorType: "Qubits", Parent: "/quantum/0", Value: 7},
+		},
+	}
+	repo, err := NewRepo(fake, fixedTime(now))
+	require.NoError(t, err)
+
+	hardware, err := repo.GetHardware(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t,
+		[]core.Hardware{
+			{ID: "/intelcpu/0", Name: "Intel Core i7", Type: core.CPU, Subtype: "Cpu"},
+			{ID: "/quantum/0", Name: "Quantum", Type: core.UnknownHardwareType, RawType: "Qpu", Subtype: "Qpu"},
+		},
+		hardware,
+	)
+
+	sensors, err := repo.GetSensors(context.Background(), core.StatsFilter{})
+	require.NoError(t, err)
+	require.Equal(t,
+		[]core.Sensor{
+			{
+				ID: "/intelcpu/0/temperature/0", HardwareID: "/intelcpu/0", Name: "CPU Package", Type: core.Temperature,
+				Value: core.SensorValue{Value: 42.5, Timestamp: now},
+			},
+			{
+				ID: "/quantum/0/qubits/0", HardwareID: "/quantum/0", Name: "Qubits", Type: core.UnknownSensorType, RawType: "Qubits",
+				Value: core.SensorValue{Value: 7, Timestamp: now},
+			},
+		},
+		sensors,
+	)
+}
+
+type fakeOHM struct {
+	hardware []ohm.Hardware
+	sensors  []ohm.Sensor
+
+	hardwareFilters ohm.HardwareFilters
+	sensorFilters   ohm.SensorFilters
+}
+
+func (f *fakeOHM) GetHardware(_ context.Context, opts ...ohm.HardwareFilter) ([]ohm.Hardware, error) {
+	for _, opt := range opts {
+		opt(&f.hardwareFilters)
+	}
+	return f.hardware, nil
+}
+
+func (f *fakeOHM) GetSensors(_ context.Context, opts ...ohm.SensorFilter) ([]ohm.Sensor, error) {
+	for _, opt := range opts {
+		opt(&f.sensorFilters)
+	}
+	return f.sensors, nil
+}
+
+type fixedTime time.Time
+
+func (t fixedTime) Now() time.Time {
+	return time.Time(t)
+}
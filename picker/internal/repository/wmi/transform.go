@@ -0,0 +1,233 @@
+package wmi
+
+import (
+	"fmt"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/pkg/ohm"
+)
+
+// toCoreHardware keeps the hardware of the types unknown to picker, so a new LibreHardwareMonitor device
+// doesn't fail the whole snapshot. Such hardware has the unknown type and the raw one.
+func (r *Repo) toCoreHardware(in []ohm.Hardware) []core.Hardware {
+	out := make([]core.Hardware, len(in))
+	for idx, h := range in {
+		hw := core.Hardware{
+			ID:       core.HardwareID(h.Identifier),
+			ParentID: core.HardwareID(h.Parent),
+			Name:     h.Name,
+			// a single core type stands for several ohm ones, e.g. the GPU of any vendor
+			Subtype: string(h.HardwareType),
+		}
+		t, err := toCoreHardwareType(h.HardwareType)
+		if err != nil {
+			hw.RawType = string(h.HardwareType)
+		}
+		hw.Type = t
+		out[idx] = hw
+	}
+
+	return out
+}
+
+func (r *Repo) toCoreSensors(in []ohm.Sensor) []core.Sensor {
+	out := make([]core.Sensor, len(in))
+	for idx, s := range in {
+		out[idx] = r.toCoreSensor(s)
+	}
+
+	return out
+}
+
+// toCoreSensor keeps the sensor of the type unknown to picker, the same way as toCoreHardware.
+func (r *Repo) toCoreSensor(in ohm.Sensor) core.Sensor {
+	var rawType string
+	t, err := toCoreSensorType(in.SensorType)
+	if err != nil {
+		rawType = string(in.SensorType)
+	}
+	return core.Sensor{
+		ID:         core.SensorID(in.Identifier),
+		HardwareID: core.HardwareID(in.Parent),
+		Name:       in.Name,
+		Type:       t,
+		RawType:    rawType,
+		Index:      in.Index,
+		Value: core.SensorValue{
+			Value:     float64(in.Value),
+			Min:       float64(in.Min),
+			Max:       float64(in.Max),
+			Timestamp: r.timegen.Now(),
+		},
+	}
+}
+
+func toCoreHardwareType(in ohm.HardwareType) (core.HardwareType, error) {
+	switch in {
+	case ohm.Mainboard, ohm.Motherboard:
+		return core.Motherboard, nil
+	case ohm.SuperIO:
+		return core.SuperIO, nil
+	case ohm.CPU:
+		return core.CPU, nil
+	case ohm.GpuNvidia, ohm.GpuAti, ohm.GpuAmd, ohm.GpuIntel:
+		return core.GPU, nil
+	case ohm.TBalancer:
+		return core.TBalancer, nil
+	case ohm.HeatMaster:
+		return core.HeatMaster, nil
+	case ohm.HDD:
+		return core.HDD, nil
+	case ohm.RAM:
+		return core.RAM, nil
+	case ohm.Network:
+		return core.Network, nil
+	case ohm.Memory:
+		return core.Memory, nil
+	case ohm.Storage:
+		return core.Storage, nil
+	case ohm.Battery:
+		return core.Battery, nil
+	case ohm.Cooler:
+		return core.Cooler, nil
+	case ohm.EmbeddedController:
+		return core.EmbeddedController, nil
+	case ohm.Psu:
+		return core.PSU, nil
+	default:
+		return core.UnknownHardwareType, fmt.Errorf("unknown hardware type: %s", in)
+	}
+}
+
+func toCoreSensorType(in ohm.SensorType) (core.SensorType, error) {
+	switch in {
+	case ohm.Voltage:
+		return core.Voltage, nil
+	case ohm.Clock:
+		return core.Clock, nil
+	case ohm.Temperature:
+		return core.Temperature, nil
+	case ohm.Load:
+		return core.Load, nil
+	case ohm.Fan:
+		return core.Fan, nil
+	case ohm.Flow:
+		return core.Flow, nil
+	case ohm.Control:
+		return core.Control, nil
+	case ohm.Level:
+		return core.Level, nil
+	case ohm.Power:
+		return core.Power, nil
+	case ohm.SmallData:
+		return core.SmallData, nil
+	case ohm.Throughput:
+		return core.Throughput, nil
+	case ohm.Data:
+		return core.Data, nil
+	case ohm.Factor:
+		return core.Factor, nil
+	case ohm.Energy:
+		return core.Energy, nil
+	case ohm.Current:
+		return core.Current, nil
+	case ohm.Frequency:
+		return core.Frequency, nil
+	case ohm.TimeSpan:
+		return core.TimeSpan, nil
+	case ohm.Noise:
+		return core.Noise, nil
+	case ohm.Conductivity:
+		return core.Conductivity, nil
+	case ohm.Humidity:
+		return core.Humidity, nil
+	default:
+		return core.UnknownSensorType, fmt.Errorf("unknown sensor type: %s", in)
+	}
+}
+
+// fromCoreHardwareType is the reverse of toCoreHardwareType, a single core type may stand for several ohm ones.
+func fromCoreHardwareType(in core.HardwareType) []ohm.HardwareType {
+	switch in {
+	case core.Motherboard:
+		return []ohm.HardwareType{ohm.Mainboard, ohm.Motherboard}
+	case core.SuperIO:
+		return []ohm.HardwareType{ohm.SuperIO}
+	case core.CPU:
+		return []ohm.HardwareType{ohm.CPU}
+	case core.GPU:
+		return []ohm.HardwareType{ohm.GpuNvidia, ohm.GpuAti, ohm.GpuAmd, ohm.GpuIntel}
+	case core.TBalancer:
+		return []ohm.HardwareType{ohm.TBalancer}
+	case core.HeatMaster:
+		return []ohm.HardwareType{ohm.HeatMaster}
+	case core.HDD:
+		return []ohm.HardwareType{ohm.HDD}
+	case core.RAM:
+		return []ohm.HardwareType{ohm.RAM}
+	case core.Network:
+		return []ohm.HardwareType{ohm.Network}
+	case core.Memory:
+		return []ohm.HardwareType{ohm.Memory}
+	case core.Storage:
+		return []ohm.HardwareType{ohm.Storage}
+	case core.Battery:
+		return []ohm.HardwareType{ohm.Battery}
+	case core.Cooler:
+		return []ohm.HardwareType{ohm.Cooler}
+	case core.EmbeddedController:
+		return []ohm.HardwareType{ohm.EmbeddedController}
+	case core.PSU:
+		return []ohm.HardwareType{ohm.Psu}
+	default:
+		return nil
+	}
+}
+
+// fromCoreSensorType is the reverse of toCoreSensorType, it returns false for the type unknown to ohm.
+func fromCoreSensorType(in core.SensorType) (ohm.SensorType, bool) {
+	switch in {
+	case core.Voltage:
+		return ohm.Voltage, true
+	case core.Clock:
+		return ohm.Clock, true
+	case core.Temperature:
+		return ohm.Temperature, true
+	case core.Load:
+		return ohm.Load, true
+	case core.Fan:
+		return ohm.Fan, true
+	case core.Flow:
+		return ohm.Flow, true
+	case core.Control:
+		return ohm.Control, true
+	case core.Level:
+		return ohm.Level, true
+	case core.Power:
+		return ohm.Power, true
+	case core.SmallData:
+		return ohm.SmallData, true
+	case core.Throughput:
+		return ohm.Throughput, true
+	case core.Data:
+		return ohm.Data, true
+	case core.Factor:
+		return ohm.Factor, true
+	case core.Energy:
+		return ohm.Energy, true
+	case core.Current:
+		return ohm.Current, true
+	case core.Frequency:
+		return ohm.Frequency, true
+	case core.TimeSpan:
+		return ohm.TimeSpan, true
+	case core.Noise:
+		return ohm.Noise, true
+	case core.Conductivity:
+		return ohm.Conductivity, true
+	case core.Humidity:
+		return ohm.Humidity, true
+	default:
+		return "", false
+	}
+}
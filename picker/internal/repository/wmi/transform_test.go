@@ -0,0 +1,106 @@
+package wmi
+
+import (
+	"testing"
+
+	"github.com/genvmoroz/win-stats/picker/internal/core"
+	"github.com/genvmoroz/win-stats/picker/pkg/ohm"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHardwareTypeRoundTrip covers the whole LibreHardwareMonitor catalogue listed by ohm, including the legacy
+// OpenHardwareMonitor types, so a type added to ohm but not to core is caught.
+func TestHardwareTypeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := map[ohm.HardwareType]core.HardwareType{
+		ohm.Mainboard:          core.Motherboard,
+		ohm.Motherboard:        core.Motherboard,
+		ohm.SuperIO:            core.SuperIO,
+		ohm.CPU:                core.CPU,
+		ohm.GpuNvidia:          core.GPU,
+		ohm.GpuAti:             core.GPU,
+		ohm.GpuAmd:             core.GPU,
+		ohm.GpuIntel:           core.GPU,
+		ohm.TBalancer:          core.TBalancer,
+		ohm.HeatMaster:         core.HeatMaster,
+		ohm.HDD:                core.HDD,
+		ohm.RAM:                core.RAM,
+		ohm.Network:            core.Network,
+		ohm.Memory:             core.Memory,
+		ohm.Storage:            core.Storage,
+		ohm.Battery:            core.Battery,
+		ohm.Cooler:             core.Cooler,
+		ohm.EmbeddedController: core.EmbeddedController,
+		ohm.Psu:                core.PSU,
+	}
+	for _, in := range ohm.HardwareTypes() {
+		t.Run(string(in), func(t *testing.T) {
+			t.Parallel()
+
+			want, ok := tests[in]
+			require.True(t, ok, "no expected core type of %s", in)
+
+			got, err := toCoreHardwareType(in)
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+			require.Contains(t, fromCoreHardwareType(got), in)
+
+			parsed, err := core.ParseHardwareType(got.String())
+			require.NoError(t, err)
+			require.Equal(t, got, parsed)
+		})
+	}
+}
+
+// TestSensorTypeRoundTrip covers all the sensor types listed by ohm the same way as TestHardwareTypeRoundTrip.
+func TestSensorTypeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := map[ohm.SensorType]struct {
+		want     core.SensorType
+		wantUnit core.Unit
+	}{
+		ohm.Voltage:      {want: core.Voltage, wantUnit: core.Volt},
+		ohm.Current:      {want: core.Current, wantUnit: core.Amperes},
+		ohm.Power:        {want: core.Power, wantUnit: core.Watts},
+		ohm.Clock:        {want: core.Clock, wantUnit: core.Megahertz},
+		ohm.Temperature:  {want: core.Temperature, wantUnit: core.Celsius},
+		ohm.Load:         {want: core.Load, wantUnit: core.Percentage},
+		ohm.Frequency:    {want: core.Frequency, wantUnit: core.Hertz},
+		ohm.Fan:          {want: core.Fan, wantUnit: core.RevolutionsPerMinute},
+		ohm.Flow:         {want: core.Flow, wantUnit: core.LitersPerHour},
+		ohm.Control:      {want: core.Control, wantUnit: core.Percentage},
+		ohm.Level:        {want: core.Level, wantUnit: core.Percentage},
+		ohm.Factor:       {want: core.Factor, wantUnit: core.Ratio},
+		ohm.Data:         {want: core.Data, wantUnit: core.Gigabytes},
+		ohm.SmallData:    {want: core.SmallData, wantUnit: core.Megabytes},
+		ohm.Throughput:   {want: core.Throughput, wantUnit: core.BytesPerSecond},
+		ohm.TimeSpan:     {want: core.TimeSpan, wantUnit: core.Seconds},
+		ohm.Energy:       {want: core.Energy, wantUnit: core.MilliwattHours},
+		ohm.Noise:        {want: core.Noise, wantUnit: core.Decibels},
+		ohm.Conductivity: {want: core.Conductivity, wantUnit: core.MicrosiemensPerCentimeter},
+		ohm.Humidity:     {want: core.Humidity, wantUnit: core.Percentage},
+	}
+	for _, in := range ohm.SensorTypes() {
+		t.Run(string(in), func(t *testing.T) {
+			t.Parallel()
+
+			test, ok := tests[in]
+			require.True(t, ok, "no expected core type of %s", in)
+
+			got, err := toCoreSensorType(in)
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+			require.Equal(t, test.wantUnit, got.Unit())
+
+			back, ok := fromCoreSensorType(got)
+			require.True(t, ok)
+			require.Equal(t, in, back)
+
+			parsed, err := core.ParseSensorType(got.String())
+			require.NoError(t, err)
+			require.Equal(t, got, parsed)
+		})
+	}
+}
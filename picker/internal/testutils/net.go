@@ -0,0 +1,22 @@
+// Package testutils holds the helpers shared by the tests of several packages.
+package testutils
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// FreePort returns the port that is free at the moment, the server under test listens on it.
+func FreePort(t *testing.T) uint {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, ln.Close())
+	}()
+
+	return uint(ln.Addr().(*net.TCPAddr).Port) //nolint:gosec // the port is in range
+}
@@ -0,0 +1,234 @@
+// Package client is the Go client of the picker HTTP API, it returns the stats as the domain types.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/genvmoroz/win-stats/domain"
+	"github.com/genvmoroz/win-stats/domain/pickerapi"
+	"github.com/samber/lo"
+)
+
+type (
+	Client struct {
+		api      *pickerapi.ClientWithResponses
+		timeout  time.Duration
+		attempts uint
+		backoff  time.Duration
+	}
+
+	// Filter selects the stats, the zero value selects all of them. The stats match all the non-empty fields
+	// and any of the values of a field.
+	Filter struct {
+		HardwareTypes []domain.HardwareType
+		SensorTypes   []domain.SensorType
+		HardwareIDs   []domain.HardwareID
+		SensorIDs     []domain.SensorID
+		// Names are the sensor name patterns, case-insensitive, with the wildcards '*' and '?'.
+		Names []string
+		// Units are the preferred units, e.g. fahrenheit or ghz, see the units parameter of the picker API.
+		Units []string
+	}
+
+	Snapshot struct {
+		Stats map[domain.Hardware][]domain.Sensor
+		// Warnings describe the issues that didn't fail the request, e.g. the hardware of an unknown type.
+		Warnings []string
+		// Age is the time since the stats were read by picker, it is zero for the stream events.
+		Age time.Duration
+		// Stale is set if picker serves the cached stats older than its retention.
+		Stale bool
+	}
+
+	// Error is the error response of picker.
+	Error struct {
+		StatusCode int
+		Message    string
+	}
+)
+
+// ErrUnexpectedResponse is returned if picker responded with the body the client can't read, such a request is not retried.
+var ErrUnexpectedResponse = errors.New("unexpected response")
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("picker responded with %d: %s", e.StatusCode, e.Message)
+}
+
+// New returns the client of the picker at the base URL, e.g. https://pc:8443.
+func New(baseURL string, opts ...Option) (*Client, error) {
+	if baseURL == "" {
+		return nil, errors.New("base URL is empty")
+	}
+
+	o := options{
+		timeout:  defaultTimeout,
+		attempts: defaultAttempts,
+		backoff:  defaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.attempts == 0 {
+		return nil, errors.New("attempts must be greater than 0")
+	}
+	if o.httpClient != nil && o.tlsConfig != nil {
+		return nil, errors.New("TLS config can't be set along with HTTP client")
+	}
+
+	httpClient := o.httpClient
+	if httpClient == nil {
+		transport, ok := http.DefaultTransport.(*http.Transport)
+		if !ok {
+			return nil, errors.New("default transport is not *http.Transport")
+		}
+		transport = transport.Clone()
+		if o.tlsConfig != nil {
+			transport.TLSClientConfig = o.tlsConfig
+		}
+		httpClient = &http.Client{Transport: transport}
+	}
+
+	apiOpts := []pickerapi.ClientOption{pickerapi.WithHTTPClient(httpClient)}
+	if o.token != "" {
+		apiOpts = append(apiOpts, pickerapi.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+o.token)
+			return nil
+		}))
+	}
+
+	api, err := pickerapi.NewClientWithResponses(baseURL, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	return &Client{
+		api:      api,
+		timeout:  o.timeout,
+		attempts: o.attempts,
+		backoff:  o.backoff,
+	}, nil
+}
+
+// GetStats returns the stats matching the filter, the precise ones of /v2/stats.
+func (c *Client) GetStats(ctx context.Context, filter Filter) (Snapshot, error) {
+	params := &pickerapi.GetStatsV2Params{
+		Layout:       lo.ToPtr(pickerapi.Flat),
+		HardwareType: namesParam(filter.HardwareTypes),
+		SensorType:   namesParam(filter.SensorTypes),
+		HardwareId:   stringsParam(filter.HardwareIDs),
+		SensorId:     stringsParam(filter.SensorIDs),
+		Name:         stringsParam(filter.Names),
+		Units:        stringsParam(filter.Units),
+	}
+
+	var out Snapshot
+	err := c.retry(ctx, func(ctx context.Context) error {
+		resp, err := c.api.GetStatsV2WithResponse(ctx, params)
+		if err != nil {
+			return fmt.Errorf("get stats: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return responseError(resp.HTTPResponse, resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return fmt.Errorf("%w: stats body is empty", ErrUnexpectedResponse)
+		}
+		out = snapshotFromAPI(*resp.JSON200)
+
+		return nil
+	})
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	return out, nil
+}
+
+// HealthCheck returns nil if picker is up, the check doesn't need the token.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	return c.retry(ctx, func(ctx context.Context) error {
+		resp, err := c.api.HealthCheckWithResponse(ctx)
+		if err != nil {
+			return fmt.Errorf("health check: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return responseError(resp.HTTPResponse, resp.Body)
+		}
+
+		return nil
+	})
+}
+
+// retry calls the request until it succeeds, fails with the error that is not worth retrying or runs out of attempts.
+// Every attempt is limited by the timeout.
+func (c *Client) retry(ctx context.Context, call func(ctx context.Context) error) error {
+	backoff := c.backoff
+	for attempt := uint(1); ; attempt++ {
+		err := c.attempt(ctx, call)
+		if err == nil || attempt == c.attempts || !retryable(ctx, err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (c *Client) attempt(ctx context.Context, call func(ctx context.Context) error) error {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	return call(ctx)
+}
+
+// retryable tells whether the error of the attempt is worth retrying, the one of the canceled request is not.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, ErrUnexpectedResponse) {
+		return false
+	}
+
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	return true
+}
+
+// responseError returns the Error with the message of picker, or the status text if the body has no message,
+// e.g. the one of a reverse proxy.
+func responseError(resp *http.Response, body []byte) error {
+	if resp == nil {
+		return fmt.Errorf("%w: response is nil", ErrUnexpectedResponse)
+	}
+
+	return &Error{
+		StatusCode: resp.StatusCode,
+		Message:    errorMessage(resp.StatusCode, body),
+	}
+}
+
+func namesParam[T fmt.Stringer](in []T) *[]string {
+	if len(in) == 0 {
+		return nil
+	}
+	return lo.ToPtr(lo.Map(in, func(v T, _ int) string { return v.String() }))
+}
+
+func stringsParam[T ~string](in []T) *[]string {
+	if len(in) == 0 {
+		return nil
+	}
+	return lo.ToPtr(lo.Map(in, func(v T, _ int) string { return string(v) }))
+}
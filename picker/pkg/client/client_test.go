@@ -0,0 +1,359 @@
+package client_test
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/genvmoroz/win-stats/domain"
+	"github.com/genvmoroz/win-stats/picker/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+const statsBody = `{
+  "Hardware": [
+    {
+      "ID": "/intelcpu/0",
+      "Name": "Intel Core i7",
+      "Type": "CPU",
+      "Subtype": "Cpu",
+      "Sensors": [
+        {
+          "ID": "/intelcpu/0/temperature/0",
+          "HardwareID": "/intelcpu/0",
+          "Name": "CPU Package",
+          "Type": "Temperature",
+          "Index": 0,
+          "Unit": "Fahrenheit",
+          "Status": "WARN",
+          "Value": {"Value": 185.5, "Min": 86, "Max": 190, "Timestamp": 1704164645}
+        }
+      ]
+    },
+    {
+      "ID": "/qpu/0",
+      "ParentID": "/mainboard",
+      "Name": "Quantum",
+      "Type": "QPU",
+      "Sensors": [
+        {
+          "ID": "/qpu/0/qubits/0",
+          "HardwareID": "/qpu/0",
+          "Name": "Qubits",
+          "Type": "UnknownSensorType",
+          "RawType": "Qubits",
+          "Unit": "UnknownUnit",
+          "Status": "OK",
+          "Value": {"Value": 1, "Min": 1, "Max": 1, "Timestamp": 1704164645}
+        }
+      ]
+    }
+  ],
+  "Warnings": ["unknown sensor type: Qubits"],
+  "Stale": true,
+  "Age": 1.5
+}`
+
+func TestClientGetStats(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		query := r.URL.Query()
+		if r.URL.Path != "/v2/stats" ||
+			query.Get("layout") != "flat" ||
+			fmt.Sprint(query["hardwareType"]) != "[CPU GPU]" ||
+			fmt.Sprint(query["hardwareId"]) != "[/intelcpu/0 /qpu/0]" ||
+			fmt.Sprint(query["units"]) != "[fahrenheit]" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(statsBody))
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.New(srv.URL, client.WithToken("secret"))
+	require.NoError(t, err)
+
+	got, err := c.GetStats(context.Background(), client.Filter{
+		HardwareTypes: []domain.HardwareType{domain.CPU, domain.GPU},
+		HardwareIDs:   []domain.HardwareID{"/intelcpu/0", "/qpu/0"},
+		Units:         []string{"fahrenheit"},
+	})
+	require.NoError(t, err)
+
+	var (
+		timestamp = time.Unix(1704164645, 0)
+		cpu       = domain.Hardware{ID: "/intelcpu/0", Name: "Intel Core i7", Type: domain.CPU, Subtype: "Cpu"}
+		qpu       = domain.Hardware{ID: "/qpu/0", ParentID: "/mainboard", Name: "Quantum", RawType: "QPU"}
+	)
+	require.Equal(t,
+		client.Snapshot{
+			Stats: map[domain.Hardware][]domain.Sensor{
+				cpu: {{
+					ID:         "/intelcpu/0/temperature/0",
+					HardwareID: cpu.ID,
+					Name:       "CPU Package",
+					Type:       domain.Temperature,
+					Unit:       domain.Fahrenheit,
+					Status:     domain.StatusWarn,
+					Value:      domain.SensorValue{Value: 185.5, Min: 86, Max: 190, Timestamp: timestamp},
+				}},
+				qpu: {{
+					ID:         "/qpu/0/qubits/0",
+					HardwareID: qpu.ID,
+					Name:       "Qubits",
+					RawType:    "Qubits",
+					Value:      domain.SensorValue{Value: 1, Min: 1, Max: 1, Timestamp: timestamp},
+				}},
+			},
+			Warnings: []string{"unknown sensor type: Qubits"},
+			Age:      1500 * time.Millisecond,
+			Stale:    true,
+		},
+		got,
+	)
+}
+
+func TestClientRetries(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		// statuses are the responses to the attempts, the last one repeats
+		statuses  []int
+		wantCalls int32
+		wantErr   *client.Error
+	}{
+		"success": {
+			statuses:  []int{http.StatusOK},
+			wantCalls: 1,
+		},
+		"recovers from unavailable": {
+			statuses:  []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			wantCalls: 3,
+		},
+		"runs out of attempts": {
+			statuses:  []int{http.StatusInternalServerError},
+			wantCalls: 3,
+			wantErr:   &client.Error{StatusCode: http.StatusInternalServerError, Message: "wmi is down"},
+		},
+		"bad request is not retried": {
+			statuses:  []int{http.StatusBadRequest},
+			wantCalls: 1,
+			wantErr:   &client.Error{StatusCode: http.StatusBadRequest, Message: "wmi is down"},
+		},
+		"message of proxy": {
+			statuses:  []int{http.StatusForbidden},
+			wantCalls: 1,
+			wantErr:   &client.Error{StatusCode: http.StatusForbidden, Message: "Forbidden"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				call := int(calls.Add(1))
+				status := test.statuses[min(call, len(test.statuses))-1]
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(status)
+				switch status {
+				case http.StatusOK:
+					_, _ = w.Write([]byte(`{"Hardware": []}`))
+				case http.StatusForbidden:
+					_, _ = w.Write([]byte(`<html>Forbidden</html>`))
+				default:
+					_, _ = fmt.Fprintf(w, `{"Message": "wmi is down", "StatusCode": %d}`, status)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			c, err := client.New(srv.URL, client.WithRetries(3, time.Millisecond))
+			require.NoError(t, err)
+
+			_, err = c.GetStats(context.Background(), client.Filter{})
+			require.Equal(t, test.wantCalls, calls.Load())
+			if test.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			var apiErr *client.Error
+			require.ErrorAs(t, err, &apiErr)
+			require.Equal(t, test.wantErr, apiErr)
+		})
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-r.Context().Done()
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.New(srv.URL, client.WithTimeout(10*time.Millisecond), client.WithRetries(2, time.Millisecond))
+	require.NoError(t, err)
+
+	err = c.HealthCheck(context.Background())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestClientHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("OK"))
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.New(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, c.HealthCheck(context.Background()))
+}
+
+func TestClientStreamStats(t *testing.T) {
+	t.Parallel()
+
+	var connections atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/stats/stream" || query.Get("mode") != "changes" || query.Get("interval") != "2" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+
+		// the first connection is dropped after two events, the second one is resumed from the last of them
+		switch connections.Add(1) {
+		case 1:
+			_, _ = fmt.Fprint(w, ": comment\n\n")
+			_, _ = fmt.Fprint(w, "id: 1\nevent: snapshot\ndata: {\"Hardware\": [{\"ID\": \"/intelcpu/0\", \"Type\": \"CPU\", \"Sensors\": []}]}\n\n")
+			_, _ = fmt.Fprint(w, "id: 2\nevent: error\ndata: {\"Message\": \"wmi is down\", \"StatusCode\": 500}\n\n")
+		case 2:
+			if r.Header.Get("Last-Event-ID") != "2" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = fmt.Fprint(w, "id: 3\nevent: changes\ndata: {\"Hardware\": []}\n\n")
+		default:
+			w.WriteHeader(http.StatusGone)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.New(srv.URL, client.WithRetries(2, time.Millisecond))
+	require.NoError(t, err)
+
+	var (
+		events []client.Event
+		done   = errors.New("done")
+	)
+	err = c.StreamStats(context.Background(), client.StreamRequest{Interval: 2 * time.Second, Changes: true},
+		func(event client.Event) error {
+			events = append(events, event)
+			if len(events) == 3 {
+				return done
+			}
+			return nil
+		},
+	)
+	require.ErrorIs(t, err, done)
+	require.Equal(t,
+		[]client.Event{
+			{
+				ID:   "1",
+				Kind: client.SnapshotEvent,
+				Snapshot: client.Snapshot{
+					Stats: map[domain.Hardware][]domain.Sensor{{ID: "/intelcpu/0", Type: domain.CPU}: {}},
+				},
+			},
+			{
+				ID:   "2",
+				Kind: client.ErrorEvent,
+				Err:  &client.Error{StatusCode: http.StatusInternalServerError, Message: "wmi is down"},
+			},
+			{
+				ID:       "3",
+				Kind:     client.ChangesEvent,
+				Snapshot: client.Snapshot{Stats: map[domain.Hardware][]domain.Sensor{}},
+			},
+		},
+		events,
+	)
+}
+
+func TestClientStreamStatsRejected(t *testing.T) {
+	t.Parallel()
+
+	var connections atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		connections.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.New(srv.URL)
+	require.NoError(t, err)
+
+	err = c.StreamStats(context.Background(), client.StreamRequest{}, func(client.Event) error { return nil })
+
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	require.Equal(t, int32(1), connections.Load())
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		baseURL string
+		opts    []client.Option
+		wantErr string
+	}{
+		"empty base URL": {
+			wantErr: "base URL is empty",
+		},
+		"no attempts": {
+			baseURL: "http://localhost:8080",
+			opts:    []client.Option{client.WithRetries(0, 0)},
+			wantErr: "attempts must be greater than 0",
+		},
+		"TLS config along with HTTP client": {
+			baseURL: "https://localhost:8443",
+			opts:    []client.Option{client.WithHTTPClient(http.DefaultClient), client.WithTLSConfig(&tls.Config{})},
+			wantErr: "TLS config can't be set along with HTTP client",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := client.New(test.baseURL, test.opts...)
+			if test.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
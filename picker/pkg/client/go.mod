@@ -0,0 +1,22 @@
+module github.com/genvmoroz/win-stats/picker/pkg/client
+
+go 1.24
+
+require (
+	github.com/genvmoroz/win-stats/domain v0.0.0
+	github.com/samber/lo v1.49.1
+	github.com/stretchr/testify v1.10.0
+)
+
+require (
+	github.com/apapsch/go-jsonmerge/v2 v2.0.0 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/google/uuid v1.5.0 // indirect
+	github.com/oapi-codegen/runtime v1.1.2 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/text v0.21.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// the domain module isn't released, it's taken from the working tree
+replace github.com/genvmoroz/win-stats/domain => ../../../domain
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultTimeout  = 10 * time.Second
+	defaultAttempts = 3
+	defaultBackoff  = 200 * time.Millisecond
+)
+
+type options struct {
+	httpClient *http.Client
+	tlsConfig  *tls.Config
+	token      string
+	timeout    time.Duration
+	attempts   uint
+	backoff    time.Duration
+}
+
+type Option func(opts *options)
+
+// WithHTTPClient replaces the default HTTP client, e.g. to use a proxy. It can't be combined with WithTLSConfig,
+// the TLS config of the given client is used.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(opts *options) {
+		opts.httpClient = httpClient
+	}
+}
+
+// WithTLSConfig sets the CA the picker certificate is verified by, or the client certificate
+// for the picker that verifies the clients.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(opts *options) {
+		opts.tlsConfig = cfg
+	}
+}
+
+// WithToken sets the bearer token, it is required by the picker started with APP_HTTP_SERVER_AUTH_TOKENS.
+func WithToken(token string) Option {
+	return func(opts *options) {
+		opts.token = token
+	}
+}
+
+// WithTimeout limits every attempt of a request, 10 seconds by default. The stream is limited
+// only until the picker responds, the events are read for as long as the context lasts. Zero disables the timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(opts *options) {
+		opts.timeout = timeout
+	}
+}
+
+// WithRetries sets the number of the attempts of a request, 3 by default, and the delay before the second one,
+// the delay doubles with every next attempt. A request is retried if the picker is unreachable,
+// the attempt timed out or the picker responded with 429 or 5xx. A single attempt disables the retries.
+func WithRetries(attempts uint, backoff time.Duration) Option {
+	return func(opts *options) {
+		opts.attempts = attempts
+		opts.backoff = backoff
+	}
+}
@@ -0,0 +1,224 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/genvmoroz/win-stats/domain/pickerapi"
+	"github.com/samber/lo"
+)
+
+type EventKind string
+
+const (
+	// SnapshotEvent carries all the stats matching the filter.
+	SnapshotEvent EventKind = "snapshot"
+	// ChangesEvent carries only the sensors that are new or whose values changed since the previous event.
+	ChangesEvent EventKind = "changes"
+	// ErrorEvent reports that picker couldn't read the stats, the stream goes on.
+	ErrorEvent EventKind = "error"
+)
+
+// maxEventSize is the limit of a single event, a snapshot of all the stats of a host is well below it.
+const maxEventSize = 16 << 20
+
+type (
+	StreamRequest struct {
+		Filter Filter
+		// Interval is the minimal time between the events, it is rounded down to seconds.
+		// Zero leaves it to picker.
+		Interval time.Duration
+		// Changes makes the events after the first snapshot carry only the changed sensors.
+		Changes bool
+	}
+
+	Event struct {
+		ID   string
+		Kind EventKind
+		// Snapshot has neither Age nor Stale, it is empty for the error event.
+		Snapshot Snapshot
+		// Err is the Error of picker, it is set only for the error event.
+		Err error
+	}
+)
+
+// errStreamEnded is returned when picker closes the stream, e.g. on restart, the stream is resumed.
+var errStreamEnded = errors.New("stream ended")
+
+// handlerError tells the error of the event handler from the one of the stream.
+type handlerError struct {
+	err error
+}
+
+func (e handlerError) Error() string {
+	return e.err.Error()
+}
+
+// StreamStats calls handle for every event until the context is canceled or handle returns an error,
+// which is returned. The lost connection is resumed from the last received event with the retries
+// of WithRetries, the retries are restored once an event is received again.
+func (c *Client) StreamStats(ctx context.Context, req StreamRequest, handle func(Event) error) error {
+	params := &pickerapi.StreamStatsParams{
+		HardwareType: namesParam(req.Filter.HardwareTypes),
+		SensorType:   namesParam(req.Filter.SensorTypes),
+		HardwareId:   stringsParam(req.Filter.HardwareIDs),
+		SensorId:     stringsParam(req.Filter.SensorIDs),
+		Name:         stringsParam(req.Filter.Names),
+		Units:        stringsParam(req.Filter.Units),
+	}
+	if req.Interval > 0 {
+		params.Interval = lo.ToPtr(max(1, int(req.Interval/time.Second)))
+	}
+	if req.Changes {
+		params.Mode = lo.ToPtr(pickerapi.Changes)
+	}
+
+	var (
+		failures uint
+		backoff  = c.backoff
+	)
+	for {
+		received := false
+		err := c.stream(ctx, params, func(event Event) error {
+			received = true
+			params.LastEventID = lo.EmptyableToPtr(event.ID)
+			if err := handle(event); err != nil {
+				return handlerError{err: err}
+			}
+			return nil
+		})
+
+		var hErr handlerError
+		if errors.As(err, &hErr) {
+			return hErr.err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if received {
+			failures, backoff = 0, c.backoff
+		}
+		failures++
+		if failures == c.attempts || !retryable(ctx, err) {
+			return fmt.Errorf("stream stats: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// stream reads the events of a single connection, the timeout limits only the wait for the response.
+func (c *Client) stream(ctx context.Context, params *pickerapi.StreamStatsParams, handle func(Event) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var timer *time.Timer
+	if c.timeout > 0 {
+		timer = time.AfterFunc(c.timeout, cancel)
+	}
+	resp, err := c.api.StreamStats(ctx, params)
+	if timer != nil && !timer.Stop() {
+		err = errors.Join(err, context.DeadlineExceeded)
+	}
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("read error response: %w", err)
+		}
+		return responseError(resp, body)
+	}
+
+	return readEvents(resp.Body, handle)
+}
+
+// readEvents parses the Server-Sent Events, only the fields picker sends are supported: id, event and data.
+func readEvents(body io.Reader, handle func(Event) error) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(nil, maxEventSize)
+
+	var (
+		id, kind string
+		data     strings.Builder
+	)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if data.Len() > 0 {
+				event, err := eventFromSSE(id, EventKind(kind), data.String())
+				if err != nil {
+					return err
+				}
+				if err = handle(event); err != nil {
+					return err
+				}
+			}
+			kind = ""
+			data.Reset()
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "id":
+			id = value
+		case "event":
+			kind = value
+		case "data":
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read events: %w", err)
+	}
+
+	return errStreamEnded
+}
+
+func eventFromSSE(id string, kind EventKind, data string) (Event, error) {
+	event := Event{ID: id, Kind: kind}
+
+	if kind == ErrorEvent {
+		var apiErr pickerapi.Error
+		if err := json.Unmarshal([]byte(data), &apiErr); err != nil {
+			return Event{}, fmt.Errorf("%w: decode error event %s: %w", ErrUnexpectedResponse, id, err)
+		}
+		event.Err = &Error{
+			StatusCode: int(lo.FromPtr(apiErr.StatusCode)),
+			Message:    lo.FromPtr(apiErr.Message),
+		}
+		return event, nil
+	}
+
+	var stats pickerapi.StatsV2
+	if err := json.Unmarshal([]byte(data), &stats); err != nil {
+		return Event{}, fmt.Errorf("%w: decode event %s: %w", ErrUnexpectedResponse, id, err)
+	}
+	event.Snapshot = snapshotFromAPI(stats)
+
+	return event, nil
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/genvmoroz/win-stats/domain"
+	"github.com/genvmoroz/win-stats/domain/pickerapi"
+	"github.com/samber/lo"
+)
+
+func snapshotFromAPI(in pickerapi.StatsV2) Snapshot {
+	hardware := lo.FromPtr(in.Hardware)
+
+	out := Snapshot{
+		Stats:    make(map[domain.Hardware][]domain.Sensor, len(hardware)),
+		Warnings: lo.FromPtr(in.Warnings),
+		Age:      time.Duration(lo.FromPtr(in.Age) * float64(time.Second)),
+		Stale:    lo.FromPtr(in.Stale),
+	}
+	for _, hw := range hardware {
+		out.Stats[hardwareFromAPI(hw)] = lo.Map(lo.FromPtr(hw.Sensors), func(s pickerapi.SensorV2, _ int) domain.Sensor {
+			return sensorFromAPI(s)
+		})
+	}
+
+	return out
+}
+
+// hardwareFromAPI keeps the hardware of the type unknown to this client, e.g. the one added by a newer picker,
+// such hardware has the unknown type and the raw one.
+func hardwareFromAPI(in pickerapi.HardwareV2) domain.Hardware {
+	out := domain.Hardware{
+		ID:       domain.HardwareID(lo.FromPtr(in.ID)),
+		ParentID: domain.HardwareID(lo.FromPtr(in.ParentID)),
+		Name:     lo.FromPtr(in.Name),
+		RawType:  lo.FromPtr(in.RawType),
+		Subtype:  lo.FromPtr(in.Subtype),
+	}
+
+	t, err := domain.ParseHardwareType(lo.FromPtr(in.Type))
+	if err != nil && out.RawType == "" {
+		out.RawType = lo.FromPtr(in.Type)
+	}
+	out.Type = t
+
+	return out
+}
+
+// sensorFromAPI keeps the sensor of the unknown type the same way as hardwareFromAPI,
+// the unknown unit and status are left zero.
+func sensorFromAPI(in pickerapi.SensorV2) domain.Sensor {
+	out := domain.Sensor{
+		ID:         domain.SensorID(lo.FromPtr(in.ID)),
+		HardwareID: domain.HardwareID(lo.FromPtr(in.HardwareID)),
+		Name:       lo.FromPtr(in.Name),
+		RawType:    lo.FromPtr(in.RawType),
+		Index:      lo.FromPtr(in.Index),
+	}
+
+	t, err := domain.ParseSensorType(lo.FromPtr(in.Type))
+	if err != nil && out.RawType == "" {
+		out.RawType = lo.FromPtr(in.Type)
+	}
+	out.Type = t
+	out.Unit, _ = domain.ParseUnit(lo.FromPtr(in.Unit))
+	out.Status, _ = domain.ParseStatus(string(lo.FromPtr(in.Status)))
+
+	if in.Value != nil {
+		out.Value = domain.SensorValue{
+			Value:     lo.FromPtr(in.Value.Value),
+			Min:       lo.FromPtr(in.Value.Min),
+			Max:       lo.FromPtr(in.Value.Max),
+			Timestamp: time.Unix(lo.FromPtr(in.Value.Timestamp), 0),
+		}
+	}
+
+	return out
+}
+
+func errorMessage(statusCode int, body []byte) string {
+	var apiErr pickerapi.Error
+	if err := json.Unmarshal(body, &apiErr); err != nil || lo.FromPtr(apiErr.Message) == "" {
+		return http.StatusText(statusCode)
+	}
+
+	return lo.FromPtr(apiErr.Message)
+}
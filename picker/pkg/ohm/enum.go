@@ -3,40 +3,64 @@ package ohm
 type HardwareType string
 
 const (
-	Mainboard   HardwareType = "Mainboard"
-	SuperIO     HardwareType = "SuperIO"
-	CPU         HardwareType = "Cpu"
-	GpuNvidia   HardwareType = "GpuNvidia"
-	GpuAti      HardwareType = "GpuAti"
-	GpuAmd      HardwareType = "GpuAmd"
-	GpuIntel    HardwareType = "GpuIntel"
-	TBalancer   HardwareType = "TBalancer"
-	HeatMaster  HardwareType = "HeatMaster"
-	HDD         HardwareType = "HDD"
-	RAM         HardwareType = "RAM"
-	Network     HardwareType = "Network"
-	Memory      HardwareType = "Memory"
-	Storage     HardwareType = "Storage"
-	Motherboard HardwareType = "Motherboard"
-	Battery     HardwareType = "Battery"
+	Mainboard          HardwareType = "Mainboard"
+	SuperIO            HardwareType = "SuperIO"
+	CPU                HardwareType = "Cpu"
+	GpuNvidia          HardwareType = "GpuNvidia"
+	GpuAti             HardwareType = "GpuAti"
+	GpuAmd             HardwareType = "GpuAmd"
+	GpuIntel           HardwareType = "GpuIntel"
+	TBalancer          HardwareType = "TBalancer"
+	HeatMaster         HardwareType = "HeatMaster"
+	HDD                HardwareType = "HDD"
+	RAM                HardwareType = "RAM"
+	Network            HardwareType = "Network"
+	Memory             HardwareType = "Memory"
+	Storage            HardwareType = "Storage"
+	Motherboard        HardwareType = "Motherboard"
+	Battery            HardwareType = "Battery"
+	Cooler             HardwareType = "Cooler"
+	Psu                HardwareType = "Psu"
+	EmbeddedController HardwareType = "EmbeddedController"
 )
 
+// HardwareTypes returns all the hardware types in the order of the declaration.
+func HardwareTypes() []HardwareType {
+	return []HardwareType{
+		Mainboard, SuperIO, CPU, GpuNvidia, GpuAti, GpuAmd, GpuIntel, TBalancer, HeatMaster, HDD, RAM,
+		Network, Memory, Storage, Motherboard, Battery, Cooler, Psu, EmbeddedController,
+	}
+}
+
 type SensorType string
 
 const (
-	Voltage     SensorType = "Voltage"     // Volt
-	Clock       SensorType = "Clock"       // Megahertz
-	Temperature SensorType = "Temperature" // Celsius
-	Load        SensorType = "Load"        // Percentage
-	Fan         SensorType = "Fan"         // Revolutions per minute
-	Flow        SensorType = "Flow"        // Liters per hour
-	Control     SensorType = "Control"     // Percentage
-	Level       SensorType = "Level"
-	Power       SensorType = "Power"      // ?
-	SmallData   SensorType = "SmallData"  // ?
-	Throughput  SensorType = "Throughput" // ?
-	Data        SensorType = "Data"       // ?
-	Factor      SensorType = "Factor"
-	Energy      SensorType = "Energy"
-	Current     SensorType = "Current"
+	Voltage      SensorType = "Voltage"      // Volt
+	Clock        SensorType = "Clock"        // Megahertz
+	Temperature  SensorType = "Temperature"  // Celsius
+	Load         SensorType = "Load"         // Percentage
+	Fan          SensorType = "Fan"          // Revolutions per minute
+	Flow         SensorType = "Flow"         // Liters per hour
+	Control      SensorType = "Control"      // Percentage
+	Level        SensorType = "Level"        // Percentage
+	Power        SensorType = "Power"        // Watt
+	SmallData    SensorType = "SmallData"    // Megabytes
+	Throughput   SensorType = "Throughput"   // Bytes per second
+	Data         SensorType = "Data"         // Gigabytes
+	Factor       SensorType = "Factor"       // Dimensionless
+	Energy       SensorType = "Energy"       // Milliwatt-hours
+	Current      SensorType = "Current"      // Ampere
+	Frequency    SensorType = "Frequency"    // Hertz
+	TimeSpan     SensorType = "TimeSpan"     // Seconds
+	Noise        SensorType = "Noise"        // A-weighted decibels
+	Humidity     SensorType = "Humidity"     // Percentage
+	Conductivity SensorType = "Conductivity" // Microsiemens per centimeter
 )
+
+// SensorTypes returns all the sensor types in the order of the declaration.
+func SensorTypes() []SensorType {
+	return []SensorType{
+		Voltage, Clock, Temperature, Load, Fan, Flow, Control, Level, Power, SmallData, Throughput,
+		Data, Factor, Energy, Current, Frequency, TimeSpan, Noise, Humidity, Conductivity,
+	}
+}
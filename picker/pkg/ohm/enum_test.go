@@ -0,0 +1,57 @@
+package ohm
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTypeLists checks that HardwareTypes and SensorTypes list every constant declared in enum.go,
+// so a type added to the const block but not to the list fails here instead of skipping the round-trip tests.
+func TestTypeLists(t *testing.T) {
+	t.Parallel()
+
+	declared := declaredConsts(t, "enum.go")
+
+	require.ElementsMatch(t, declared["HardwareType"], lo.Map(HardwareTypes(), func(in HardwareType, _ int) string {
+		return string(in)
+	}))
+	require.ElementsMatch(t, declared["SensorType"], lo.Map(SensorTypes(), func(in SensorType, _ int) string {
+		return string(in)
+	}))
+}
+
+// declaredConsts returns the values of the typed string constants of the file by the name of their type.
+func declaredConsts(t *testing.T, filename string) map[string][]string {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	require.NoError(t, err)
+
+	out := make(map[string][]string)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec) //nolint:forcetypeassert // the specs of a const declaration are values
+			typ, ok := value.Type.(*ast.Ident)
+			require.True(t, ok, "constant %s has no type", value.Names[0].Name)
+			for _, v := range value.Values {
+				lit, ok := v.(*ast.BasicLit)
+				require.True(t, ok, "constant %s is not a literal", value.Names[0].Name)
+				s, err := strconv.Unquote(lit.Value)
+				require.NoError(t, err)
+				out[typ.Name] = append(out[typ.Name], s)
+			}
+		}
+	}
+
+	return out
+}
@@ -1,8 +1,8 @@
 package ohm
 
-import (
-	"fmt"
-	"strings"
+const (
+	hardwareClass = "Hardware"
+	sensorClass   = "Sensor"
 )
 
 type SensorFilters struct {
@@ -10,80 +10,81 @@ type SensorFilters struct {
 	Name             *string
 	ParentIdentifier *string
 	Type             *SensorType
+	// Types matches the sensors of any of the types.
+	Types []SensorType
+	// Where is an arbitrary predicate, e.g. Not(Like("Name", "%Core%")).
+	Where Predicate
 }
 
 type SensorFilter func(filter *SensorFilters)
 
-func narrowSensorsQuery(opts ...SensorFilter) string {
-	if len(opts) == 0 {
-		return ""
-	}
-
+// sensorsQuery builds the query selecting the sensors matching all the filters.
+func sensorsQuery(opts ...SensorFilter) string {
 	filter := SensorFilters{}
 
 	for _, opt := range opts {
 		opt(&filter)
 	}
 
-	condition := &conditionQuery{}
+	query := Select(sensorClass)
 
 	if filter.Identifier != nil {
-		condition.and(fmt.Sprintf("Identifier = '%s' ", *filter.Identifier))
+		query = query.Where(Equal("Identifier", *filter.Identifier))
 	}
 	if filter.Name != nil {
-		condition.and(fmt.Sprintf("Name = '%s' ", *filter.Name))
+		query = query.Where(Equal("Name", *filter.Name))
 	}
 	if filter.ParentIdentifier != nil {
-		condition.and(fmt.Sprintf("Parent = '%s' ", *filter.ParentIdentifier))
+		query = query.Where(Equal("Parent", *filter.ParentIdentifier))
 	}
 	if filter.Type != nil {
-		condition.and(fmt.Sprintf("SensorType = '%s' ", *filter.Type))
+		query = query.Where(Equal("SensorType", string(*filter.Type)))
+	}
+	if len(filter.Types) != 0 {
+		query = query.Where(In("SensorType", toStrings(filter.Types)...))
 	}
 
-	return condition.String()
+	return query.Where(filter.Where).String()
 }
 
 type HardwareFilters struct {
-	Type *HardwareType
+	Identifier *string
+	Type       *HardwareType
+	// Types matches the hardware of any of the types.
+	Types []HardwareType
+	// Where is an arbitrary predicate, e.g. Like("Name", "%NVIDIA%").
+	Where Predicate
 }
 
 type HardwareFilter func(filter *HardwareFilters)
 
-func narrowHardwareQuery(opts ...HardwareFilter) string {
-	if len(opts) == 0 {
-		return ""
-	}
-
+// hardwareQuery builds the query selecting the hardware matching all the filters.
+func hardwareQuery(opts ...HardwareFilter) string {
 	filter := HardwareFilters{}
 
 	for _, opt := range opts {
 		opt(&filter)
 	}
 
-	condition := &conditionQuery{}
+	query := Select(hardwareClass)
 
+	if filter.Identifier != nil {
+		query = query.Where(Equal("Identifier", *filter.Identifier))
+	}
 	if filter.Type != nil {
-		condition.and(fmt.Sprintf("HardwareType = '%s' ", *filter.Type))
+		query = query.Where(Equal("HardwareType", string(*filter.Type)))
+	}
+	if len(filter.Types) != 0 {
+		query = query.Where(In("HardwareType", toStrings(filter.Types)...))
 	}
 
-	return condition.String()
-}
-
-type conditionQuery struct {
-	b         strings.Builder
-	hasPrefix bool
+	return query.Where(filter.Where).String()
 }
 
-func (c *conditionQuery) and(val string) {
-	if c.hasPrefix {
-		c.b.WriteString("AND ")
-	} else {
-		c.b.WriteString("WHERE ")
-		c.hasPrefix = true
+func toStrings[T ~string](in []T) []string {
+	out := make([]string, len(in))
+	for idx, v := range in {
+		out[idx] = string(v)
 	}
-	c.b.WriteString(val)
-}
-
-func (c *conditionQuery) String() string {
-	return c.b.String()
+	return out
 }
@@ -4,16 +4,11 @@ package ohm
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/yusufpapurcu/wmi"
 )
 
-const (
-	namespace           = "root\\LibreHardwareMonitor"
-	selectHardwareQuery = "SELECT * FROM Hardware"
-	selectSensorsQuery  = "SELECT * FROM Sensor"
-)
+const namespace = "root\\LibreHardwareMonitor"
 
 type Repo struct {
 	queryExecutor func(query string, dst any, namespace string) error
@@ -35,14 +30,8 @@ func (r *Repo) GetSensors(ctx context.Context, opts ...SensorFilter) ([]Sensor,
 
 func (r *Repo) getHardware(opts ...HardwareFilter) func() ([]Hardware, error) {
 	return func() ([]Hardware, error) {
-		query := selectHardwareQuery
-		condition := narrowHardwareQuery(opts...)
-		if len(condition) != 0 {
-			query = fmt.Sprintf("%s %s", query, condition)
-		}
-
 		var res []Hardware
-		if err := r.queryExecutor(query, &res, namespace); err != nil {
+		if err := r.queryExecutor(hardwareQuery(opts...), &res, namespace); err != nil {
 			return nil, err
 		}
 		return res, nil
@@ -51,14 +40,8 @@ func (r *Repo) getHardware(opts ...HardwareFilter) func() ([]Hardware, error) {
 
 func (r *Repo) getSensors(opts ...SensorFilter) func() ([]Sensor, error) {
 	return func() ([]Sensor, error) {
-		query := selectSensorsQuery
-		condition := narrowSensorsQuery(opts...)
-		if len(condition) != 0 {
-			query = fmt.Sprintf("%s %s", query, condition)
-		}
-
 		var res []Sensor
-		if err := r.queryExecutor(query, &res, namespace); err != nil {
+		if err := r.queryExecutor(sensorsQuery(opts...), &res, namespace); err != nil {
 			return nil, err
 		}
 		return res, nil
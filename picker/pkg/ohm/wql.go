@@ -0,0 +1,136 @@
+package ohm
+
+import (
+	"slices"
+	"strings"
+)
+
+// Query is a WQL SELECT query.
+// See https://learn.microsoft.com/en-us/windows/win32/wmisdk/wql-sql-for-wmi
+type Query struct {
+	class string
+	where Predicate
+}
+
+// Select starts the query returning all the properties of the class instances.
+func Select(class string) Query {
+	return Query{class: class}
+}
+
+// Where narrows the query, it is combined by AND with the predicate set before.
+func (q Query) Where(p Predicate) Query {
+	q.where = And(q.where, p)
+	return q
+}
+
+func (q Query) String() string {
+	query := "SELECT * FROM " + q.class
+	if !q.where.IsEmpty() {
+		query += " WHERE " + q.where.String()
+	}
+	return query
+}
+
+type operator string
+
+const (
+	andOperator operator = "AND"
+	orOperator  operator = "OR"
+)
+
+// Predicate is a condition of the WHERE clause. The zero value is empty and matches everything,
+// it is skipped when combined with other predicates.
+type Predicate struct {
+	expr string
+	// op is set for the compound predicates, it decides whether they must be parenthesized when nested
+	op operator
+}
+
+// Equal matches the instances whose property equals the value.
+func Equal(property, value string) Predicate {
+	return Predicate{expr: property + " = " + quote(value)}
+}
+
+// Like matches the instances whose property matches the pattern, e.g. "CPU Core #%".
+// The pattern wildcards are kept as is, use EscapeLike to match a literal text.
+func Like(property, pattern string) Predicate {
+	return Predicate{expr: property + " LIKE " + quote(pattern)}
+}
+
+// In matches the instances whose property equals any of the values.
+// WQL has no IN operator, so the values are expanded to the OR of Equal predicates.
+// The predicate is empty if there are no values.
+func In(property string, values ...string) Predicate {
+	predicates := make([]Predicate, len(values))
+	for idx, value := range values {
+		predicates[idx] = Equal(property, value)
+	}
+	return Or(predicates...)
+}
+
+// And matches the instances matching all the predicates.
+func And(predicates ...Predicate) Predicate {
+	return combine(andOperator, predicates)
+}
+
+// Or matches the instances matching any of the predicates.
+func Or(predicates ...Predicate) Predicate {
+	return combine(orOperator, predicates)
+}
+
+// Not matches the instances not matching the predicate. It is empty if the predicate is empty.
+func Not(p Predicate) Predicate {
+	if p.IsEmpty() {
+		return Predicate{}
+	}
+	return Predicate{expr: "NOT (" + p.expr + ")"}
+}
+
+func (p Predicate) IsEmpty() bool {
+	return p.expr == ""
+}
+
+func (p Predicate) String() string {
+	return p.expr
+}
+
+func combine(op operator, predicates []Predicate) Predicate {
+	predicates = slices.DeleteFunc(slices.Clone(predicates), Predicate.IsEmpty)
+
+	switch len(predicates) {
+	case 0:
+		return Predicate{}
+	case 1:
+		// a single predicate keeps its own operator, so it is still parenthesized when nested
+		return predicates[0]
+	}
+
+	parts := make([]string, len(predicates))
+	for idx, p := range predicates {
+		if p.op != "" && p.op != op {
+			parts[idx] = "(" + p.expr + ")"
+			continue
+		}
+		parts[idx] = p.expr
+	}
+
+	return Predicate{
+		expr: strings.Join(parts, " "+string(op)+" "),
+		op:   op,
+	}
+}
+
+// quote makes a WQL string literal, escaping the backslashes and the single quotes.
+func quote(value string) string {
+	return "'" + literalEscaper.Replace(value) + "'"
+}
+
+var (
+	literalEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	likeEscaper    = strings.NewReplacer(`[`, `[[]`, `%`, `[%]`, `_`, `[_]`)
+)
+
+// EscapeLike escapes the LIKE wildcards, so the text is matched literally, e.g. "100%" or "CPU_1".
+func EscapeLike(text string) string {
+	return likeEscaper.Replace(text)
+}
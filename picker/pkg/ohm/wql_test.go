@@ -0,0 +1,154 @@
+package ohm
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryString(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		query Query
+		want  string
+	}{
+		"without condition": {
+			query: Select("Sensor"),
+			want:  "SELECT * FROM Sensor",
+		},
+		"empty condition": {
+			query: Select("Sensor").Where(And()).Where(Or(In("SensorType"))).Where(Not(Predicate{})),
+			want:  "SELECT * FROM Sensor",
+		},
+		"equal": {
+			query: Select("Sensor").Where(Equal("Name", "CPU Package")),
+			want:  "SELECT * FROM Sensor WHERE Name = 'CPU Package'",
+		},
+		"escaped quote and backslash": {
+			query: Select("Hardware").Where(Equal("Name", `O'Brien's \SSD`)),
+			want:  `SELECT * FROM Hardware WHERE Name = 'O\'Brien\'s \\SSD'`,
+		},
+		"where is combined by and": {
+			query: Select("Sensor").Where(Equal("Parent", "/intelcpu/0")).Where(Equal("SensorType", "Load")),
+			want:  "SELECT * FROM Sensor WHERE Parent = '/intelcpu/0' AND SensorType = 'Load'",
+		},
+		"in": {
+			query: Select("Sensor").Where(In("SensorType", "Load", "Temperature")),
+			want:  "SELECT * FROM Sensor WHERE SensorType = 'Load' OR SensorType = 'Temperature'",
+		},
+		"single in": {
+			query: Select("Sensor").Where(In("SensorType", "Load")),
+			want:  "SELECT * FROM Sensor WHERE SensorType = 'Load'",
+		},
+		"or nested in and": {
+			query: Select("Sensor").
+				Where(Equal("Parent", "/intelcpu/0")).
+				Where(In("SensorType", "Load", "Clock")),
+			want: "SELECT * FROM Sensor WHERE Parent = '/intelcpu/0' AND (SensorType = 'Load' OR SensorType = 'Clock')",
+		},
+		"and nested in or": {
+			query: Select("Sensor").Where(Or(
+				And(Equal("SensorType", "Load"), Like("Name", "CPU%")),
+				Equal("SensorType", "Clock"),
+			)),
+			want: "SELECT * FROM Sensor WHERE (SensorType = 'Load' AND Name LIKE 'CPU%') OR SensorType = 'Clock'",
+		},
+		"same operators are flattened": {
+			query: Select("Sensor").Where(Or(Or(Equal("Name", "a"), Equal("Name", "b")), Equal("Name", "c"))),
+			want:  "SELECT * FROM Sensor WHERE Name = 'a' OR Name = 'b' OR Name = 'c'",
+		},
+		"not": {
+			query: Select("Sensor").Where(Not(In("SensorType", "Data", "SmallData"))).Where(Like("Name", "GPU%")),
+			want:  "SELECT * FROM Sensor WHERE NOT (SensorType = 'Data' OR SensorType = 'SmallData') AND Name LIKE 'GPU%'",
+		},
+		"escaped like": {
+			query: Select("Sensor").Where(Like("Name", "%"+EscapeLike("CPU_1 [100%]")+"%")),
+			want:  "SELECT * FROM Sensor WHERE Name LIKE '%CPU[_]1 [[]100[%]]%'",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.want, test.query.String())
+		})
+	}
+}
+
+func TestSensorsQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts []SensorFilter
+		want string
+	}{
+		"no filters": {
+			want: "SELECT * FROM Sensor",
+		},
+		"all filters": {
+			opts: []SensorFilter{
+				func(f *SensorFilters) {
+					f.Identifier = lo.ToPtr("/intelcpu/0/load/0")
+					f.Name = lo.ToPtr("CPU Total")
+					f.ParentIdentifier = lo.ToPtr("/intelcpu/0")
+					f.Type = lo.ToPtr(Load)
+				},
+			},
+			want: "SELECT * FROM Sensor WHERE Identifier = '/intelcpu/0/load/0' AND Name = 'CPU Total' " +
+				"AND Parent = '/intelcpu/0' AND SensorType = 'Load'",
+		},
+		"types and predicate": {
+			opts: []SensorFilter{
+				func(f *SensorFilters) { f.Types = []SensorType{Temperature, Fan} },
+				func(f *SensorFilters) { f.Where = Not(Like("Name", "%Distance%")) },
+			},
+			want: "SELECT * FROM Sensor WHERE (SensorType = 'Temperature' OR SensorType = 'Fan') AND NOT (Name LIKE '%Distance%')",
+		},
+		"name with quote": {
+			opts: []SensorFilter{func(f *SensorFilters) { f.Name = lo.ToPtr("Fan 'Rear'") }},
+			want: `SELECT * FROM Sensor WHERE Name = 'Fan \'Rear\''`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.want, sensorsQuery(test.opts...))
+		})
+	}
+}
+
+func TestHardwareQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts []HardwareFilter
+		want string
+	}{
+		"no filters": {
+			want: "SELECT * FROM Hardware",
+		},
+		"type": {
+			opts: []HardwareFilter{func(f *HardwareFilters) { f.Type = lo.ToPtr(CPU) }},
+			want: "SELECT * FROM Hardware WHERE HardwareType = 'Cpu'",
+		},
+		"types and predicate": {
+			opts: []HardwareFilter{func(f *HardwareFilters) {
+				f.Identifier = lo.ToPtr("/gpu-nvidia/0")
+				f.Types = []HardwareType{GpuNvidia, GpuAmd}
+				f.Where = Like("Name", "%RTX%")
+			}},
+			want: "SELECT * FROM Hardware WHERE Identifier = '/gpu-nvidia/0' " +
+				"AND (HardwareType = 'GpuNvidia' OR HardwareType = 'GpuAmd') AND Name LIKE '%RTX%'",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.want, hardwareQuery(test.opts...))
+		})
+	}
+}
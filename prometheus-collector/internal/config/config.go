@@ -5,13 +5,15 @@ import (
 
 	"github.com/genvmoroz/win-stats-prometheus-collector/internal/core"
 	"github.com/genvmoroz/win-stats-prometheus-collector/internal/infrastructure"
+	"github.com/genvmoroz/win-stats-prometheus-collector/internal/repository/picker"
 	"github.com/go-playground/validator/v10"
 	"github.com/kelseyhightower/envconfig"
 )
 
 type Config struct {
-	LogLevel    string   `envconfig:"APP_LOG_LEVEL" default:"debug"`
-	PickerHosts []string `envconfig:"APP_PICKER_HOSTS" validate:"required"`
+	LogLevel string `envconfig:"APP_LOG_LEVEL" default:"debug"`
+	// PickerHosts are the picker base URLs, each may be followed by its credentials, see picker.HostConfig.
+	PickerHosts []picker.HostConfig `envconfig:"APP_PICKER_HOSTS" validate:"required"`
 
 	CoreService core.Config
 	Infra       infrastructure.Config
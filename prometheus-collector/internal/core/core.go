@@ -14,7 +14,7 @@ import (
 
 type (
 	StatsReporter interface {
-		ReportSensorValue(value int64, host, hardwareID, hardwareName, hardwareType, sensorID, sensorName, sensorType string)
+		ReportSensorValue(host string, hardware Hardware, sensor Sensor)
 	}
 
 	StatsProvider interface {
@@ -131,18 +131,9 @@ func (s *Service) collectStatsFromOneProvider(ctx context.Context, host string,
 		return fmt.Errorf("get stats: %w", err)
 	}
 
-	for _, hardware := range stats.Hardware {
-		for _, sensor := range hardware.Sensors {
-			s.statsReporter.ReportSensorValue(
-				sensor.Value.Value,
-				host,
-				hardware.ID,
-				hardware.Name,
-				hardware.Type,
-				sensor.ID,
-				sensor.Name,
-				sensor.Type,
-			)
+	for hardware, sensors := range stats.SensorsByHardware {
+		for _, sensor := range sensors {
+			s.statsReporter.ReportSensorValue(host, hardware, sensor)
 		}
 	}
 
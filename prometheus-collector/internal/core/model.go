@@ -1,25 +1,16 @@
 package core
 
-type Stats struct {
-	Hardware []Hardware
-}
-
-type Hardware struct {
-	ID      string
-	Name    string
-	Sensors []Sensor
-	Type    string
-}
+import "github.com/genvmoroz/win-stats/domain"
 
-type Sensor struct {
-	ID   string
-	Name string
-	Type string
+// The model is shared with picker by the domain module.
+type (
+	HardwareID  = domain.HardwareID
+	SensorID    = domain.SensorID
+	Hardware    = domain.Hardware
+	Sensor      = domain.Sensor
+	SensorValue = domain.SensorValue
+)
 
-	Value SensorValue
-}
-
-type SensorValue struct {
-	Timestamp int64
-	Value     int64
+type Stats struct {
+	SensorsByHardware map[Hardware][]Sensor
 }
@@ -79,8 +79,9 @@ func NewStatsPickerRepos(ctx context.Context) func(injector *do.Injector) (map[s
 		cfg := do.MustInvoke[config.Config](injector)
 
 		providers := make(map[string]core.StatsProvider, len(cfg.PickerHosts))
-		for _, host := range cfg.PickerHosts {
-			repo, err := picker.NewRepo(ctx, host)
+		for _, hostCfg := range cfg.PickerHosts {
+			host := hostCfg.URL
+			repo, err := picker.NewRepo(ctx, hostCfg)
 			if err != nil {
 				return nil, fmt.Errorf("init picker repo for host %s: %w", host, err)
 			}
@@ -0,0 +1,98 @@
+package picker
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// HostConfig is a picker host with its credentials. It is decoded from the base URL followed by
+// the semicolon-separated options, e.g. https://pc:8443;token=secret;ca=/etc/picker/ca.pem. The options are:
+//   - token or tokenFile: the bearer token or the file containing it;
+//   - ca: the CA the picker certificate is verified by instead of the system ones;
+//   - cert and key: the client certificate for the picker that verifies the clients.
+type HostConfig struct {
+	URL      string
+	Token    string
+	CAFile   string
+	CertFile string
+	KeyFile  string
+}
+
+// Decode implements envconfig.Decoder.
+func (c *HostConfig) Decode(value string) error {
+	parts := strings.Split(value, ";")
+
+	cfg := HostConfig{URL: strings.TrimSpace(parts[0])}
+	if cfg.URL == "" {
+		return errors.New("host is empty")
+	}
+
+	for _, option := range parts[1:] {
+		name, val, ok := strings.Cut(strings.TrimSpace(option), "=")
+		if !ok || val == "" {
+			return fmt.Errorf("host %s: option %q must be name=value", cfg.URL, option)
+		}
+
+		switch name {
+		case "token":
+			cfg.Token = val
+		case "tokenFile":
+			content, err := os.ReadFile(val)
+			if err != nil {
+				return fmt.Errorf("host %s: read token: %w", cfg.URL, err)
+			}
+			cfg.Token = strings.TrimSpace(string(content))
+		case "ca":
+			cfg.CAFile = val
+		case "cert":
+			cfg.CertFile = val
+		case "key":
+			cfg.KeyFile = val
+		default:
+			return fmt.Errorf("host %s: unknown option %q", cfg.URL, name)
+		}
+	}
+
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return fmt.Errorf("host %s: cert and key must be set together", cfg.URL)
+	}
+
+	*c = cfg
+
+	return nil
+}
+
+// tlsConfig returns nil if the host uses neither a custom CA nor a client certificate.
+func (c HostConfig) tlsConfig() (*tls.Config, error) {
+	if c.CAFile == "" && c.CertFile == "" {
+		return nil, nil //nolint:nilnil // the default TLS config is used
+	}
+
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if c.CAFile != "" {
+		pem, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("CA has no certificates")
+		}
+		cfg.RootCAs = pool
+	}
+
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
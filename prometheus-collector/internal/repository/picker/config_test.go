@@ -0,0 +1,93 @@
+package picker_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/genvmoroz/win-stats-prometheus-collector/internal/repository/picker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostConfigDecode(t *testing.T) {
+	t.Parallel()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("from-file\n"), 0o600))
+
+	tests := map[string]struct {
+		value   string
+		want    picker.HostConfig
+		wantErr string
+	}{
+		"url only": {
+			value: "http://pc:8080",
+			want:  picker.HostConfig{URL: "http://pc:8080"},
+		},
+		"all options": {
+			value: " https://pc:8443 ; token=secret ; ca=/etc/picker/ca.pem;cert=/etc/picker/client.pem;key=/etc/picker/client.key",
+			want: picker.HostConfig{
+				URL:      "https://pc:8443",
+				Token:    "secret",
+				CAFile:   "/etc/picker/ca.pem",
+				CertFile: "/etc/picker/client.pem",
+				KeyFile:  "/etc/picker/client.key",
+			},
+		},
+		"token file": {
+			value: "https://pc:8443;tokenFile=" + tokenFile,
+			want:  picker.HostConfig{URL: "https://pc:8443", Token: "from-file"},
+		},
+		"token value with equals sign": {
+			value: "https://pc:8443;token=a=b",
+			want:  picker.HostConfig{URL: "https://pc:8443", Token: "a=b"},
+		},
+		"empty": {
+			value:   "",
+			wantErr: "host is empty",
+		},
+		"options without url": {
+			value:   ";token=secret",
+			wantErr: "host is empty",
+		},
+		"option without value": {
+			value:   "https://pc:8443;token=",
+			wantErr: `option "token=" must be name=value`,
+		},
+		"option without name=value": {
+			value:   "https://pc:8443;secret",
+			wantErr: `option "secret" must be name=value`,
+		},
+		"unknown option": {
+			value:   "https://pc:8443;password=secret",
+			wantErr: `unknown option "password"`,
+		},
+		"missing token file": {
+			value:   "https://pc:8443;tokenFile=" + filepath.Join(t.TempDir(), "missing"),
+			wantErr: "read token",
+		},
+		"cert without key": {
+			value:   "https://pc:8443;cert=/etc/picker/client.pem",
+			wantErr: "cert and key must be set together",
+		},
+		"key without cert": {
+			value:   "https://pc:8443;key=/etc/picker/client.key",
+			wantErr: "cert and key must be set together",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got picker.HostConfig
+			err := got.Decode(test.value)
+			if test.wantErr != "" {
+				require.ErrorContains(t, err, test.wantErr)
+				require.Zero(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+		})
+	}
+}
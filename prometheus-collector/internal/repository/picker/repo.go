@@ -2,29 +2,51 @@ package picker
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"sync/atomic"
 
 	"github.com/genvmoroz/win-stats-prometheus-collector/internal/core"
-	openapi "github.com/genvmoroz/win-stats-prometheus-collector/internal/repository/picker/generated"
+	"github.com/genvmoroz/win-stats/domain/pickerapi"
 	"github.com/hashicorp/go-cleanhttp"
 )
 
 type Repo struct {
-	client      *openapi.Client
+	client      *pickerapi.Client
 	transformer Transformer
+	// legacyAPI is set once the picker responds that it doesn't serve /v2/stats,
+	// the deprecated /stats with the rounded values is used from then on.
+	legacyAPI atomic.Bool
 }
 
-func NewRepo(ctx context.Context, host string) (*Repo, error) {
-	if host == "" {
+func NewRepo(ctx context.Context, cfg HostConfig) (*Repo, error) {
+	if cfg.URL == "" {
 		return nil, fmt.Errorf("host is empty")
 	}
 
-	opts := []openapi.ClientOption{
-		openapi.WithBaseURL(host),
-		openapi.WithHTTPClient(cleanhttp.DefaultClient()),
+	tlsConfig, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, fmt.Errorf("tls config: %w", err)
+	}
+	// the same client as cleanhttp.DefaultClient, with the host's TLS config
+	transport := cleanhttp.DefaultTransport()
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	opts := []pickerapi.ClientOption{
+		pickerapi.WithBaseURL(cfg.URL),
+		pickerapi.WithHTTPClient(&http.Client{Transport: transport}),
+	}
+	if cfg.Token != "" {
+		opts = append(opts, pickerapi.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+cfg.Token)
+			return nil
+		}))
 	}
 
-	client, err := openapi.NewClient(host, opts...)
+	client, err := pickerapi.NewClient(cfg.URL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("create client: %w", err)
 	}
@@ -38,7 +60,37 @@ func NewRepo(ctx context.Context, host string) (*Repo, error) {
 }
 
 func (r *Repo) GetStats(ctx context.Context) (core.Stats, error) {
-	resp, err := r.client.GetStats(ctx)
+	if !r.legacyAPI.Load() {
+		stats, err := r.getStatsV2(ctx)
+		if !errors.Is(err, errNotFound) {
+			return stats, err
+		}
+		r.legacyAPI.Store(true)
+	}
+
+	return r.getStatsV1(ctx)
+}
+
+func (r *Repo) getStatsV2(ctx context.Context) (core.Stats, error) {
+	resp, err := r.client.GetStatsV2(ctx, nil)
+	if err != nil {
+		return core.Stats{}, fmt.Errorf("get stats v2: %w", err)
+	}
+	defer func() {
+		if resp != nil && resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	stats, err := handleResponse(resp, pickerapi.ParseGetStatsV2Response, r.transformer.GetStatsV2ResponseFromOpenAPI)
+	if err != nil {
+		return core.Stats{}, err
+	}
+
+	return stats, nil
+}
+
+func (r *Repo) getStatsV1(ctx context.Context) (core.Stats, error) {
+	resp, err := r.client.GetStats(ctx, nil)
 	if err != nil {
 		return core.Stats{}, fmt.Errorf("get stats: %w", err)
 	}
@@ -47,7 +99,7 @@ func (r *Repo) GetStats(ctx context.Context) (core.Stats, error) {
 			_ = resp.Body.Close()
 		}
 	}()
-	stats, err := handleResponse(resp, openapi.ParseGetStatsResponse, r.transformer.GetStatsResponseFromOpenAPI)
+	stats, err := handleResponse(resp, pickerapi.ParseGetStatsResponse, r.transformer.GetStatsResponseFromOpenAPI)
 	if err != nil {
 		return core.Stats{}, err
 	}
@@ -65,7 +117,7 @@ func (r *Repo) HealthCheck(ctx context.Context) error {
 			_ = resp.Body.Close()
 		}
 	}()
-	_, err = handleResponse(resp, openapi.ParseHealthCheckResponse, r.transformer.HealthCheckFromOpenAPI)
+	_, err = handleResponse(resp, pickerapi.ParseHealthCheckResponse, r.transformer.HealthCheckFromOpenAPI)
 	if err != nil {
 		return err
 	}
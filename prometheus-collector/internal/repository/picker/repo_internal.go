@@ -2,10 +2,11 @@ package picker
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
-	openapi "github.com/genvmoroz/win-stats-prometheus-collector/internal/repository/picker/generated"
+	"github.com/genvmoroz/win-stats/domain/pickerapi"
 	"github.com/samber/lo"
 )
 
@@ -37,17 +38,22 @@ func handleResponse[FROM any, TO any](
 	return to, nil
 }
 
+// errNotFound is returned when the picker doesn't serve the endpoint, e.g. an older version is deployed.
+var errNotFound = errors.New("endpoint not found")
+
 func checkError(resp *http.Response) error {
 	switch {
 	case resp.StatusCode == http.StatusOK:
 		return nil
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%w: %w", errNotFound, extractErrorFromResponseBody(resp))
 	default:
 		return extractErrorFromResponseBody(resp)
 	}
 }
 
 func extractErrorFromResponseBody(resp *http.Response) error {
-	var em openapi.Error
+	var em pickerapi.Error
 	if err := json.NewDecoder(resp.Body).Decode(&em); err != nil {
 		return fmt.Errorf(
 			"unmarshal error message from server response (StatusCode=%d): %w",
@@ -2,78 +2,163 @@ package picker
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/genvmoroz/win-stats-prometheus-collector/internal/core"
-	openapi "github.com/genvmoroz/win-stats-prometheus-collector/internal/repository/picker/generated"
+	"github.com/genvmoroz/win-stats/domain"
+	"github.com/genvmoroz/win-stats/domain/pickerapi"
 	"github.com/samber/lo"
 )
 
 type Transformer struct{}
 
-func (t Transformer) GetStatsResponseFromOpenAPI(resp openapi.GetStatsResponse) (core.Stats, error) {
+func (t Transformer) GetStatsResponseFromOpenAPI(resp pickerapi.GetStatsResponse) (core.Stats, error) {
 	if resp.JSON200 == nil {
 		return core.Stats{}, fmt.Errorf("response body is nil")
 	}
 	return t.statsFromOpenAPI(*resp.JSON200), nil
 }
 
-func (t Transformer) statsFromOpenAPI(in openapi.Stats) core.Stats {
-	return core.Stats{
-		Hardware: t.multipleHardwareFromOpenAPI(lo.FromPtr(in.Hardware)),
-	}
-}
+func (t Transformer) statsFromOpenAPI(in pickerapi.Stats) core.Stats {
+	hardware := lo.FromPtr(in.Hardware)
 
-func (t Transformer) multipleHardwareFromOpenAPI(in []openapi.Hardware) []core.Hardware {
-	if in == nil {
-		return nil
+	out := core.Stats{
+		SensorsByHardware: make(map[core.Hardware][]core.Sensor, len(hardware)),
 	}
-
-	out := make([]core.Hardware, len(in))
-	for idx, hw := range in {
-		out[idx] = t.hardwareFromOpenAPI(hw)
+	for _, hw := range hardware {
+		coreHardware := t.hardwareFromOpenAPI(hw)
+		out.SensorsByHardware[coreHardware] = lo.Map(lo.FromPtr(hw.Sensors), func(s pickerapi.Sensor, _ int) core.Sensor {
+			return t.sensorFromOpenAPI(coreHardware.ID, s)
+		})
 	}
 
 	return out
 }
 
-func (t Transformer) hardwareFromOpenAPI(in openapi.Hardware) core.Hardware {
+func (t Transformer) hardwareFromOpenAPI(in pickerapi.Hardware) core.Hardware {
+	hwType, rawType := hardwareTypeFromOpenAPI(lo.FromPtr(in.Type), "")
+
 	return core.Hardware{
-		ID:      lo.FromPtr(in.ID),
+		ID:      core.HardwareID(lo.FromPtr(in.ID)),
 		Name:    lo.FromPtr(in.Name),
-		Sensors: t.sensorsFromOpenAPI(lo.FromPtr(in.Sensors)),
-		Type:    lo.FromPtr(in.Type),
+		Type:    hwType,
+		RawType: rawType,
+		Subtype: lo.FromPtr(in.Subtype),
+	}
+}
+
+// sensorFromOpenAPI takes the hardware ID, the sensors of /stats are nested in the hardware without it.
+func (t Transformer) sensorFromOpenAPI(hardwareID core.HardwareID, in pickerapi.Sensor) core.Sensor {
+	sensorType, rawType := sensorTypeFromOpenAPI(lo.FromPtr(in.Type), lo.FromPtr(in.RawType))
+	value := lo.FromPtr(in.Value)
+
+	return core.Sensor{
+		ID:         core.SensorID(lo.FromPtr(in.ID)),
+		HardwareID: hardwareID,
+		Name:       lo.FromPtr(in.Name),
+		Type:       sensorType,
+		RawType:    rawType,
+		Index:      lo.FromPtr(in.Index),
+		Unit:       unitFromOpenAPI(lo.FromPtr(in.Unit)),
+		Status:     statusFromOpenAPI(in.Status),
+		Value: core.SensorValue{
+			Value:     float64(lo.FromPtr(value.Value)),
+			Min:       float64(lo.FromPtr(value.Min)),
+			Max:       float64(lo.FromPtr(value.Max)),
+			Timestamp: time.Unix(lo.FromPtr(value.Timestamp), 0),
+		},
 	}
 }
 
-func (t Transformer) sensorsFromOpenAPI(in []openapi.Sensor) []core.Sensor {
-	if in == nil {
-		return nil
+func (t Transformer) GetStatsV2ResponseFromOpenAPI(resp pickerapi.GetStatsV2Response) (core.Stats, error) {
+	if resp.JSON200 == nil {
+		return core.Stats{}, fmt.Errorf("response body is nil")
 	}
+	return t.statsV2FromOpenAPI(*resp.JSON200), nil
+}
 
-	out := make([]core.Sensor, len(in))
-	for idx, s := range in {
-		out[idx] = t.sensorFromOpenAPI(s)
+func (t Transformer) statsV2FromOpenAPI(in pickerapi.StatsV2) core.Stats {
+	hardware := lo.FromPtr(in.Hardware)
+
+	out := core.Stats{
+		SensorsByHardware: make(map[core.Hardware][]core.Sensor, len(hardware)),
+	}
+	for _, hw := range hardware {
+		out.SensorsByHardware[t.hardwareV2FromOpenAPI(hw)] = lo.Map(lo.FromPtr(hw.Sensors), func(s pickerapi.SensorV2, _ int) core.Sensor {
+			return t.sensorV2FromOpenAPI(s)
+		})
 	}
 
 	return out
 }
 
-func (t Transformer) sensorFromOpenAPI(in openapi.Sensor) core.Sensor {
-	return core.Sensor{
-		ID:    lo.FromPtr(in.ID),
-		Name:  lo.FromPtr(in.Name),
-		Type:  lo.FromPtr(in.Type),
-		Value: t.sensorValueFromOpenAPI(lo.FromPtr(in.Value)),
+func (t Transformer) hardwareV2FromOpenAPI(in pickerapi.HardwareV2) core.Hardware {
+	hwType, rawType := hardwareTypeFromOpenAPI(lo.FromPtr(in.Type), lo.FromPtr(in.RawType))
+
+	return core.Hardware{
+		ID:       core.HardwareID(lo.FromPtr(in.ID)),
+		ParentID: core.HardwareID(lo.FromPtr(in.ParentID)),
+		Name:     lo.FromPtr(in.Name),
+		Type:     hwType,
+		RawType:  rawType,
+		Subtype:  lo.FromPtr(in.Subtype),
 	}
 }
 
-func (t Transformer) sensorValueFromOpenAPI(in openapi.SensorValue) core.SensorValue {
-	return core.SensorValue{
-		Timestamp: lo.FromPtr(in.Timestamp),
-		Value:     lo.FromPtr(in.Value),
+func (t Transformer) sensorV2FromOpenAPI(in pickerapi.SensorV2) core.Sensor {
+	sensorType, rawType := sensorTypeFromOpenAPI(lo.FromPtr(in.Type), lo.FromPtr(in.RawType))
+	value := lo.FromPtr(in.Value)
+
+	return core.Sensor{
+		ID:         core.SensorID(lo.FromPtr(in.ID)),
+		HardwareID: core.HardwareID(lo.FromPtr(in.HardwareID)),
+		Name:       lo.FromPtr(in.Name),
+		Type:       sensorType,
+		RawType:    rawType,
+		Index:      lo.FromPtr(in.Index),
+		Unit:       unitFromOpenAPI(lo.FromPtr(in.Unit)),
+		Status:     statusFromOpenAPI(in.Status),
+		Value: core.SensorValue{
+			Value:     lo.FromPtr(value.Value),
+			Min:       lo.FromPtr(value.Min),
+			Max:       lo.FromPtr(value.Max),
+			Timestamp: time.Unix(lo.FromPtr(value.Timestamp), 0),
+		},
 	}
 }
 
-func (t Transformer) HealthCheckFromOpenAPI(_ openapi.HealthCheckResponse) (struct{}, error) {
+func (t Transformer) HealthCheckFromOpenAPI(_ pickerapi.HealthCheckResponse) (struct{}, error) {
 	return struct{}{}, nil
 }
+
+// hardwareTypeFromOpenAPI keeps the type unknown to the collector, e.g. added by a newer picker,
+// as the raw one of the unknown type.
+func hardwareTypeFromOpenAPI(name, rawType string) (domain.HardwareType, string) {
+	t, err := domain.ParseHardwareType(name)
+	if err != nil && rawType == "" {
+		rawType = name
+	}
+
+	return t, rawType
+}
+
+// sensorTypeFromOpenAPI keeps the unknown type the same way as hardwareTypeFromOpenAPI.
+func sensorTypeFromOpenAPI(name, rawType string) (domain.SensorType, string) {
+	t, err := domain.ParseSensorType(name)
+	if err != nil && rawType == "" {
+		rawType = name
+	}
+
+	return t, rawType
+}
+
+// unitFromOpenAPI leaves the unknown unit zero, it is not a part of the labels.
+func unitFromOpenAPI(name string) domain.Unit {
+	unit, _ := domain.ParseUnit(name)
+	return unit
+}
+
+func statusFromOpenAPI(in *pickerapi.HealthStatus) domain.Status {
+	status, _ := domain.ParseStatus(string(lo.FromPtr(in)))
+	return status
+}
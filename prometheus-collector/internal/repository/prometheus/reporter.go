@@ -4,17 +4,19 @@ package prometheus
 import (
 	"fmt"
 
+	"github.com/genvmoroz/win-stats/domain"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 const (
-	hostLabel         = "host"
-	hardwareIDLabel   = "hardwareID"
-	hardwareNameLabel = "hardwareName"
-	hardwareTypeLabel = "hardwareType"
-	sensorIDLabel     = "sensorID"
-	sensorNameLabel   = "sensorName"
-	sensorTypeLabel   = "sensorType"
+	hostLabel            = "host"
+	hardwareIDLabel      = "hardwareID"
+	hardwareNameLabel    = "hardwareName"
+	hardwareTypeLabel    = "hardwareType"
+	hardwareSubtypeLabel = "hardwareSubtype"
+	sensorIDLabel        = "sensorID"
+	sensorNameLabel      = "sensorName"
+	sensorTypeLabel      = "sensorType"
 )
 
 type StatsReporter struct {
@@ -28,7 +30,10 @@ func NewStatsReporter() *StatsReporter {
 				Name: "sensor_value",
 				Help: "Sensor value",
 			},
-			[]string{hostLabel, hardwareIDLabel, hardwareNameLabel, hardwareTypeLabel, sensorIDLabel, sensorNameLabel, sensorTypeLabel},
+			[]string{
+				hostLabel, hardwareIDLabel, hardwareNameLabel, hardwareTypeLabel, hardwareSubtypeLabel,
+				sensorIDLabel, sensorNameLabel, sensorTypeLabel,
+			},
 		),
 	}
 }
@@ -41,18 +46,21 @@ func (r *StatsReporter) Register() error {
 	return nil
 }
 
-func (r *StatsReporter) ReportSensorValue(value int64, host, hardwareID, hardwareName, hardwareType, sensorID, sensorName, sensorType string) {
+// ReportSensorValue labels the value with the names of the domain types, the types unknown to the collector,
+// e.g. added by a newer picker, are labeled as the unknown ones.
+func (r *StatsReporter) ReportSensorValue(host string, hardware domain.Hardware, sensor domain.Sensor) {
 	r.sensorValueGaugeVec.
 		With(
 			map[string]string{
-				hostLabel:         host,
-				hardwareIDLabel:   hardwareID,
-				hardwareNameLabel: hardwareName,
-				hardwareTypeLabel: hardwareType,
-				sensorIDLabel:     sensorID,
-				sensorNameLabel:   sensorName,
-				sensorTypeLabel:   sensorType,
+				hostLabel:            host,
+				hardwareIDLabel:      string(hardware.ID),
+				hardwareNameLabel:    hardware.Name,
+				hardwareTypeLabel:    hardware.Type.String(),
+				hardwareSubtypeLabel: hardware.Subtype,
+				sensorIDLabel:        string(sensor.ID),
+				sensorNameLabel:      sensor.Name,
+				sensorTypeLabel:      sensor.Type.String(),
 			},
 		).
-		Set(float64(value))
+		Set(sensor.Value.Value)
 }